@@ -0,0 +1,343 @@
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The built in roles. Admin can use every RPC.
+const (
+	RoleViewer   = "viewer"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+// anonymous is the name of requests without a token, when there's a default role.
+const anonymous = "anonymous"
+
+// viewerPermissions are the RPCs screens and `tickerwall describe` need.
+// nolint:gochecknoglobals // constant.
+var viewerPermissions = []string{
+	"JoinCluster", "SyncClock", "GetTickers", "GetScreenCluster", "ListTickers",
+	"ListWalls", "ListAnnouncements", "GetDataSourceHealth", "GetSnapshot",
+}
+
+// operatorPermissions are the RPCs for running the wall, on top of the viewers.
+// nolint:gochecknoglobals // constant.
+var operatorPermissions = []string{
+	"UpdatePresentationSettings", "Announce", "AddTicker", "RemoveTicker", "UpdateScreen",
+}
+
+// selfService RPCs can be used by anyone who is signed in, the leader checks what they are allowed
+// to change. Screens update their own size with UpdateScreen, but only roles with the UpdateScreen
+// permission can change other screens.
+// nolint:gochecknoglobals // constant.
+var selfService = map[string]bool{
+	"UpdateScreen": true,
+}
+
+// AccessControl is who can use the leader, and what they can do. It's read from the `auth` section
+// of the tickerwall config file, eg:
+//
+//	auth:
+//	  default-role: viewer
+//	  roles:
+//	    intern: [viewer, Announce]
+//	  users:
+//	    - name: sam
+//	      token: sams-secret-token
+//	      role: intern
+//	    - name: lobby
+//	      certificate: lobby.example.com
+//	      role: viewer
+type AccessControl struct {
+	// Users who can sign in, with a token or a client certificate.
+	Users []User `mapstructure:"users"`
+
+	// Roles are extra roles, on top of viewer, operator and admin. Each entry is an RPC name, or the
+	// name of another role to include.
+	Roles map[string][]string `mapstructure:"roles"`
+
+	// DefaultRole is the role of requests without a token. They are rejected when it's empty.
+	DefaultRole string `mapstructure:"default-role"`
+}
+
+// User is someone who can sign in to the leader.
+type User struct {
+	Name string `mapstructure:"name"`
+
+	// Token signs the user in, sent as a bearer token.
+	Token string `mapstructure:"token"`
+
+	// Certificate signs the user in with a client certificate ( mTLS ) with this common name.
+	Certificate string `mapstructure:"certificate"`
+
+	Role string `mapstructure:"role"`
+}
+
+// Identity is who made a request.
+type Identity struct {
+	Name string
+	Role string
+
+	permissions map[string]bool
+}
+
+// Can is true when the identity's role has the permission to use the RPC.
+func (i *Identity) Can(rpc string) bool {
+	return i.permissions[rpc]
+}
+
+// Anonymous is true when the request didn't sign in, and was given the default role. Anonymous
+// requests all share the same name, so they don't own anything.
+func (i *Identity) Anonymous() bool {
+	return i.Name == anonymous
+}
+
+// Authorize checks the identity can use the RPC.
+func (i *Identity) Authorize(rpc string) error {
+	if selfService[rpc] || i.Can(rpc) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "the %s role can't use %s", i.Role, rpc)
+}
+
+type identityKey struct{}
+
+// NewContext returns a context carrying the identity.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity of a request. There's no identity when access control isn't
+// enabled.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+// AccessControlEnabled is true when there's a token or users. Without them everyone can do everything.
+func (c *Config) AccessControlEnabled() bool {
+	return c.Token != "" || len(c.Access.Users) > 0
+}
+
+// Authenticate finds who made a request, from it's Authorization header and the verified client
+// certificate of it's connection. The token set with --token is an admin.
+func (c *Config) Authenticate(authorization string, state *tls.ConnectionState) (*Identity, error) {
+	if !c.AccessControlEnabled() {
+		return c.identity(anonymous, RoleAdmin), nil
+	}
+
+	if authorization != "" {
+		if c.Token != "" && c.ValidToken(authorization) {
+			return c.identity(RoleAdmin, RoleAdmin), nil
+		}
+
+		token := strings.TrimPrefix(authorization, bearerPrefix)
+		for _, user := range c.Access.Users {
+			if user.Token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(user.Token)) == 1 {
+				return c.identity(user.Name, user.Role), nil
+			}
+		}
+
+		// A wrong token is an error, even when there's a default role.
+		return nil, status.Error(codes.Unauthenticated, "a valid token is required")
+	}
+
+	if cert := verifiedCertificate(state); cert != nil {
+		for _, user := range c.Access.Users {
+			if user.Certificate != "" && user.Certificate == cert.Subject.CommonName {
+				return c.identity(user.Name, user.Role), nil
+			}
+		}
+	}
+
+	if c.Access.DefaultRole != "" {
+		return c.identity(anonymous, c.Access.DefaultRole), nil
+	}
+
+	return nil, status.Error(codes.Unauthenticated, "a valid token is required")
+}
+
+func (c *Config) identity(name, role string) *Identity {
+	return &Identity{
+		Name:        name,
+		Role:        role,
+		permissions: c.permissions(role),
+	}
+}
+
+// permissions resolves the RPCs a role can use, following the roles it includes.
+func (c *Config) permissions(role string) map[string]bool {
+	perms := map[string]bool{}
+	c.addPermissions(perms, strings.ToLower(role), map[string]bool{})
+	return perms
+}
+
+func (c *Config) addPermissions(perms map[string]bool, role string, seen map[string]bool) {
+	if seen[role] {
+		return
+	}
+	seen[role] = true
+
+	switch role {
+	case RoleAdmin:
+		for _, rpc := range RPCs() {
+			perms[rpc] = true
+		}
+		return
+	case RoleOperator:
+		c.addPermissions(perms, RoleViewer, seen)
+		for _, rpc := range operatorPermissions {
+			perms[rpc] = true
+		}
+		return
+	case RoleViewer:
+		for _, rpc := range viewerPermissions {
+			perms[rpc] = true
+		}
+		return
+	}
+
+	for _, entry := range c.roleEntries(role) {
+		if rpc, ok := findRPC(entry); ok {
+			perms[rpc] = true
+			continue
+		}
+		c.addPermissions(perms, strings.ToLower(entry), seen)
+	}
+}
+
+// roleEntries are the entries of a role from the config file. Role names aren't case sensitive,
+// config files lower case them.
+func (c *Config) roleEntries(role string) []string {
+	for name, entries := range c.Access.Roles {
+		if strings.EqualFold(name, role) {
+			return entries
+		}
+	}
+	return nil
+}
+
+// Validate checks the roles and users make sense, so mistakes are found when the leader starts
+// instead of when someone is denied.
+func (c *Config) Validate() error {
+	for name, entries := range c.Access.Roles {
+		if isBuiltInRole(name) {
+			return fmt.Errorf("the %s role is built in, it can't be changed", name)
+		}
+		for _, entry := range entries {
+			if _, ok := findRPC(entry); !ok && !c.roleExists(entry) {
+				return fmt.Errorf("role %s: %s is not an RPC or a role", name, entry)
+			}
+		}
+	}
+
+	for i, user := range c.Access.Users {
+		if user.Name == "" {
+			return fmt.Errorf("user %d has no name", i+1)
+		}
+		if user.Token == "" && user.Certificate == "" {
+			return fmt.Errorf("user %s needs a token or a certificate", user.Name)
+		}
+		if user.Certificate != "" && c.CAFile == "" {
+			return fmt.Errorf("user %s signs in with a certificate, which needs a CA to verify it ( --tls-ca )", user.Name)
+		}
+		if !c.roleExists(user.Role) {
+			return fmt.Errorf("user %s has an unknown role: %s", user.Name, user.Role)
+		}
+	}
+
+	if c.Access.DefaultRole != "" && !c.roleExists(c.Access.DefaultRole) {
+		return fmt.Errorf("unknown default role: %s", c.Access.DefaultRole)
+	}
+
+	return nil
+}
+
+func (c *Config) roleExists(role string) bool {
+	return isBuiltInRole(role) || c.roleEntries(role) != nil
+}
+
+// Describe is the access control as a model, for GetAccessControl. Tokens are left out.
+func (c *Config) Describe() *models.AccessControl {
+	names := []string{RoleViewer, RoleOperator, RoleAdmin}
+	custom := make([]string, 0, len(c.Access.Roles))
+	for name := range c.Access.Roles {
+		custom = append(custom, name)
+	}
+	sort.Strings(custom)
+
+	access := &models.AccessControl{
+		Enabled:     c.AccessControlEnabled(),
+		DefaultRole: c.Access.DefaultRole,
+	}
+	for _, name := range append(names, custom...) {
+		role := &models.Role{Name: name, BuiltIn: isBuiltInRole(name)}
+		for _, rpc := range RPCs() {
+			if c.permissions(name)[rpc] {
+				role.Permissions = append(role.Permissions, rpc)
+			}
+		}
+		access.Roles = append(access.Roles, role)
+	}
+
+	if c.Token != "" {
+		access.Users = append(access.Users, &models.User{Name: RoleAdmin, Role: RoleAdmin, Token: true})
+	}
+	for _, user := range c.Access.Users {
+		access.Users = append(access.Users, &models.User{
+			Name:        user.Name,
+			Role:        user.Role,
+			Token:       user.Token != "",
+			Certificate: user.Certificate,
+		})
+	}
+
+	return access
+}
+
+func isBuiltInRole(role string) bool {
+	switch strings.ToLower(role) {
+	case RoleViewer, RoleOperator, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// RPCs are the names of the Leader services RPCs, which are the permissions roles are made of.
+func RPCs() []string {
+	methods := models.File_models_proto.Services().ByName("Leader").Methods()
+	rpcs := make([]string, 0, methods.Len())
+	for i := 0; i < methods.Len(); i++ {
+		rpcs = append(rpcs, string(methods.Get(i).Name()))
+	}
+	return rpcs
+}
+
+// findRPC finds the RPC with the name, which isn't case sensitive.
+func findRPC(name string) (string, bool) {
+	for _, rpc := range RPCs() {
+		if strings.EqualFold(rpc, name) {
+			return rpc, true
+		}
+	}
+	return "", false
+}
+
+// verifiedCertificate is the client certificate of a connection, when it was verified by our CA.
+func verifiedCertificate(state *tls.ConnectionState) *x509.Certificate {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testAccessConfig() *Config {
+	return &Config{
+		Token:  "admin-token",
+		CAFile: "ca.pem",
+		Access: AccessControl{
+			Roles: map[string][]string{
+				"intern": {"viewer", "Announce"},
+				"lead":   {"intern", "addticker"},
+			},
+			Users: []User{
+				{Name: "sam", Token: "sam-token", Role: "intern"},
+				{Name: "kim", Token: "kim-token", Role: "lead"},
+				{Name: "olly", Token: "olly-token", Role: "operator"},
+				{Name: "lobby", Certificate: "lobby.example.com", Role: "viewer"},
+			},
+		},
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	cfg := testAccessConfig()
+	lobby := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{
+		{Subject: pkix.Name{CommonName: "lobby.example.com"}},
+	}}}
+
+	tests := []struct {
+		name          string
+		authorization string
+		state         *tls.ConnectionState
+		rpc           string
+		want          codes.Code
+	}{
+		{"admin kicks screens", Bearer("admin-token"), nil, "KickScreen", codes.OK},
+		{"admin reads access control", Bearer("admin-token"), nil, "GetAccessControl", codes.OK},
+		{"intern announces", Bearer("sam-token"), nil, "Announce", codes.OK},
+		{"intern joins", Bearer("sam-token"), nil, "JoinCluster", codes.OK},
+		{"intern can't recolor", Bearer("sam-token"), nil, "UpdatePresentationSettings", codes.PermissionDenied},
+		{"intern can't add tickers", Bearer("sam-token"), nil, "AddTicker", codes.PermissionDenied},
+		{"included role", Bearer("kim-token"), nil, "Announce", codes.OK},
+		{"role entry case", Bearer("kim-token"), nil, "AddTicker", codes.OK},
+		{"lead can't remove tickers", Bearer("kim-token"), nil, "RemoveTicker", codes.PermissionDenied},
+		{"operator recolors", Bearer("olly-token"), nil, "UpdatePresentationSettings", codes.OK},
+		{"operator can't kick", Bearer("olly-token"), nil, "KickScreen", codes.PermissionDenied},
+		{"operator can't read access control", Bearer("olly-token"), nil, "GetAccessControl", codes.PermissionDenied},
+		{"certificate viewer", "", lobby, "GetTickers", codes.OK},
+		{"certificate viewer can't announce", "", lobby, "Announce", codes.PermissionDenied},
+		{"viewer updates own screen", "", lobby, "UpdateScreen", codes.OK},
+		{"unknown certificate", "", &tls.ConnectionState{}, "GetTickers", codes.Unauthenticated},
+		{"wrong token", Bearer("nope"), lobby, "GetTickers", codes.Unauthenticated},
+		{"no token", "", nil, "GetTickers", codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := cfg.Authenticate(tt.authorization, tt.state)
+			if err == nil {
+				err = id.Authorize(tt.rpc)
+			}
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("%s = %v, want %v", tt.rpc, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultRole(t *testing.T) {
+	cfg := testAccessConfig()
+	cfg.Access.DefaultRole = "viewer"
+
+	id, err := cfg.Authenticate("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !id.Can("GetTickers") || id.Can("Announce") {
+		t.Errorf("default role permissions = %v, want the viewers", id.permissions)
+	}
+
+	// A wrong token isn't given the default role.
+	if _, err := cfg.Authenticate(Bearer("nope"), nil); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Authenticate() with a wrong token = %v, want Unauthenticated", err)
+	}
+}
+
+func TestAccessControlDisabled(t *testing.T) {
+	id, err := (&Config{}).Authenticate("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rpc := range RPCs() {
+		if !id.Can(rpc) {
+			t.Errorf("without access control %s should be allowed", rpc)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := testAccessConfig().Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		access AccessControl
+	}{
+		{"unknown permission", AccessControl{Roles: map[string][]string{"intern": {"Recolor"}}}},
+		{"built in role", AccessControl{Roles: map[string][]string{"Viewer": {"Announce"}}}},
+		{"unknown role", AccessControl{Users: []User{{Name: "sam", Token: "t", Role: "intern"}}}},
+		{"no name", AccessControl{Users: []User{{Token: "t", Role: "viewer"}}}},
+		{"no token or certificate", AccessControl{Users: []User{{Name: "sam", Role: "viewer"}}}},
+		{"unknown default role", AccessControl{DefaultRole: "intern"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{CAFile: "ca.pem", Access: tt.access}
+			if err := cfg.Validate(); err == nil {
+				t.Error("Validate() should fail")
+			}
+		})
+	}
+
+	// Certificates can't be verified without a CA.
+	cfg := &Config{Access: AccessControl{Users: []User{{Name: "lobby", Certificate: "lobby", Role: "viewer"}}}}
+	if err := cfg.Validate(); err == nil {
+		t.Error("Validate() of a certificate user without a CA should fail")
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	access := testAccessConfig().Describe()
+	if !access.Enabled {
+		t.Error("access control should be enabled")
+	}
+
+	for _, user := range access.Users {
+		if user.Name == "sam" && (!user.Token || user.Role != "intern") {
+			t.Errorf("sam = %v, want an intern with a token", user)
+		}
+	}
+
+	for _, role := range access.Roles {
+		if role.Name == "intern" && (role.BuiltIn || len(role.Permissions) != len(viewerPermissions)+1) {
+			t.Errorf("intern = %v, want the viewers permissions and Announce", role)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Package auth secures the connections between the leader and it's clients. Connections can use
+// TLS, optionally with client certificates ( mTLS ), and bearer tokens. Users sign in with a token
+// or a client certificate, and their role decides which RPCs they can use. Everything is off by
+// default, so a leader on a trusted network works without any setup.
+package auth
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// bearerPrefix is the scheme of the Authorization header, eg: Authorization: Bearer {token}
+const bearerPrefix = "Bearer "
+
+// Config is how connections to the leader are secured. The same settings are used by the leader
+// and it's clients, from their point of view: the certificate is their own, and the CA verifies
+// the other side.
+type Config struct {
+	// Token is the bearer token. When it's set, the leader requires a token and clients send it.
+	// The leader treats it as an admin.
+	Token string
+
+	// TLS turns on TLS. It's on when any of the files are set too.
+	TLS bool
+
+	// CertFile and KeyFile are our own certificate. The leader needs one to use TLS, clients only
+	// need one when the leader requires client certificates.
+	CertFile string
+	KeyFile  string
+
+	// CAFile verifies the other side. A leader given a CA requires clients to have a certificate
+	// signed by it ( mTLS ). Clients use the system's CAs when it's empty.
+	CAFile string
+
+	// Access is the leaders users and roles. Clients don't use it.
+	Access AccessControl
+
+	// AllowedOrigins are the other sites whose pages can open WebSockets to the leader, eg:
+	// https://dashboard.example.com. The leader's own pages are always allowed, "*" allows any site.
+	// Clients don't use it.
+	AllowedOrigins []string
+}
+
+// AllowedOrigin checks if a page from origin can open a WebSocket to the leader at host. Requests
+// without an Origin aren't from a browser, so they are allowed.
+func (c *Config) AllowedOrigin(origin, host string) bool {
+	if origin == "" {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, host) {
+		return true
+	}
+
+	for _, allowed := range c.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// TLSEnabled is true when connections use TLS.
+func (c *Config) TLSEnabled() bool {
+	return c.TLS || c.CertFile != "" || c.KeyFile != "" || c.CAFile != ""
+}
+
+// ServerTLS is the TLS config for the leaders servers, or nil when TLS isn't enabled.
+func (c *Config) ServerTLS() (*tls.Config, error) {
+	if !c.TLSEnabled() {
+		return nil, nil
+	}
+
+	if c.CertFile == "" || c.KeyFile == "" {
+		return nil, errors.New("the leader needs a certificate and key to use TLS")
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	// mTLS, clients need a certificate signed by our CA.
+	if c.CAFile != "" {
+		pool, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg, nil
+}
+
+// ClientTLS is the TLS config for connecting to the leader, or nil when TLS isn't enabled.
+func (c *Config) ClientTLS() (*tls.Config, error) {
+	if !c.TLSEnabled() {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.CAFile != "" {
+		pool, err := loadCertPool(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	// Our certificate, for leaders which require client certificates.
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
+
+// ValidToken checks the value of an Authorization header. Any token is valid when no token is set.
+func (c *Config) ValidToken(authorization string) bool {
+	if c.Token == "" {
+		return true
+	}
+
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return false
+	}
+	token := strings.TrimPrefix(authorization, bearerPrefix)
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(c.Token)) == 1
+}
+
+// Authorization is the value of the Authorization header clients send, empty when there's no token.
+func (c *Config) Authorization() string {
+	if c.Token == "" {
+		return ""
+	}
+	return Bearer(c.Token)
+}
+
+// Bearer is the Authorization header value for a token.
+func Bearer(token string) string {
+	return bearerPrefix + token
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read CA: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", file)
+	}
+	return pool, nil
+}
@@ -0,0 +1,270 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidToken(t *testing.T) {
+	cfg := &Config{Token: "secret"}
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          bool
+	}{
+		{"valid", "Bearer secret", true},
+		{"wrong token", "Bearer nope", false},
+		{"prefix of token", "Bearer secre", false},
+		{"missing scheme", "secret", false},
+		{"wrong scheme", "Basic secret", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.ValidToken(tt.authorization); got != tt.want {
+				t.Errorf("ValidToken(%q) = %v, want %v", tt.authorization, got, tt.want)
+			}
+		})
+	}
+
+	// Without a token everything is allowed.
+	if !(&Config{}).ValidToken("") {
+		t.Error("ValidToken without a token set should allow everything")
+	}
+}
+
+func TestAllowedOrigin(t *testing.T) {
+	cfg := &Config{AllowedOrigins: []string{"https://dashboard.example.com/"}}
+
+	tests := []struct {
+		name   string
+		origin string
+		want   bool
+	}{
+		{"not a browser", "", true},
+		{"the leaders own page", "http://leader:6887", true},
+		{"allowed site", "https://dashboard.example.com", true},
+		{"other site", "https://evil.example.com", false},
+		{"same host on another port", "http://leader:8080", false},
+		{"invalid", "://", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.AllowedOrigin(tt.origin, "leader:6887"); got != tt.want {
+				t.Errorf("AllowedOrigin(%q) = %v, want %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+
+	if !(&Config{AllowedOrigins: []string{"*"}}).AllowedOrigin("https://evil.example.com", "leader:6887") {
+		t.Error("AllowedOrigin with * should allow any site")
+	}
+}
+
+func TestServerTLSNeedsCertificate(t *testing.T) {
+	if _, err := (&Config{TLS: true}).ServerTLS(); err == nil {
+		t.Error("ServerTLS without a certificate should fail")
+	}
+
+	tlsConfig, err := (&Config{}).ServerTLS()
+	if err != nil || tlsConfig != nil {
+		t.Errorf("ServerTLS without TLS = %v, %v, want nil, nil", tlsConfig, err)
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	certs := writeTestCerts(t)
+
+	serverCfg := &Config{
+		Token:    "secret",
+		CertFile: certs.serverCert,
+		KeyFile:  certs.serverKey,
+		CAFile:   certs.ca,
+		Access: AccessControl{
+			Roles: map[string][]string{"announcer": {"Announce"}},
+			Users: []User{{Name: "sam", Token: "sams-secret", Role: "announcer"}},
+		},
+	}
+	addr := startTestServer(t, serverCfg)
+
+	tests := []struct {
+		name   string
+		client Config
+		want   codes.Code
+	}{
+		{
+			// The test server doesn't implement anything, so getting through is Unimplemented.
+			name:   "valid",
+			client: Config{Token: "secret", CertFile: certs.clientCert, KeyFile: certs.clientKey, CAFile: certs.ca},
+			want:   codes.Unimplemented,
+		},
+		{
+			name:   "wrong token",
+			client: Config{Token: "nope", CertFile: certs.clientCert, KeyFile: certs.clientKey, CAFile: certs.ca},
+			want:   codes.Unauthenticated,
+		},
+		{
+			name:   "role without the RPC",
+			client: Config{Token: "sams-secret", CertFile: certs.clientCert, KeyFile: certs.clientKey, CAFile: certs.ca},
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "no token",
+			client: Config{CertFile: certs.clientCert, KeyFile: certs.clientKey, CAFile: certs.ca},
+			want:   codes.Unauthenticated,
+		},
+		{
+			name:   "no client certificate",
+			client: Config{Token: "secret", CAFile: certs.ca},
+			want:   codes.Unavailable,
+		},
+		{
+			name:   "no TLS",
+			client: Config{Token: "secret"},
+			want:   codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := tt.client.DialOptions()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			conn, err := grpc.Dial(addr, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			_, err = models.NewLeaderClient(conn).ListWalls(ctx, &models.Empty{})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("ListWalls() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func startTestServer(t *testing.T, cfg *Config) string {
+	t.Helper()
+
+	opts, err := cfg.ServerOptions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := grpc.NewServer(opts...)
+	models.RegisterLeaderServer(srv, &models.UnimplementedLeaderServer{})
+	go srv.Serve(lis) // nolint:errcheck // ends when the test stops the server.
+	t.Cleanup(srv.Stop)
+
+	// The certificate is for localhost.
+	_, port, _ := net.SplitHostPort(lis.Addr().String())
+	return net.JoinHostPort("localhost", port)
+}
+
+type testCerts struct {
+	ca                    string
+	serverCert, serverKey string
+	clientCert, clientKey string
+}
+
+// writeTestCerts writes a CA, and a server and client certificate signed by it.
+func writeTestCerts(t *testing.T) testCerts {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certs := testCerts{ca: filepath.Join(dir, "ca.pem")}
+	writePEM(t, certs.ca, "CERTIFICATE", caDER)
+
+	issue := func(name string, serial int64, usage x509.ExtKeyUsage) (string, string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: name},
+			DNSNames:     []string{"localhost"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		certFile, keyFile := filepath.Join(dir, name+".pem"), filepath.Join(dir, name+"-key.pem")
+		writePEM(t, certFile, "CERTIFICATE", der)
+		writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+		return certFile, keyFile
+	}
+
+	certs.serverCert, certs.serverKey = issue("server", 2, x509.ExtKeyUsageServerAuth)
+	certs.clientCert, certs.clientKey = issue("client", 3, x509.ExtKeyUsageClientAuth)
+	return certs
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(file, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"path"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// authorizationKey is the gRPC metadata key the token is sent with.
+const authorizationKey = "authorization"
+
+// DialOptions are the gRPC options for connecting to the leader.
+func (c *Config) DialOptions() ([]grpc.DialOption, error) {
+	tlsConfig, err := c.ClientTLS()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []grpc.DialOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	if c.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{
+			authorization: c.Authorization(),
+			secure:        tlsConfig != nil,
+		}))
+	}
+
+	return opts, nil
+}
+
+// ServerOptions are the gRPC options for the leaders server.
+func (c *Config) ServerOptions() ([]grpc.ServerOption, error) {
+	tlsConfig, err := c.ServerTLS()
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []grpc.ServerOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	if c.AccessControlEnabled() {
+		opts = append(opts,
+			grpc.UnaryInterceptor(c.unaryInterceptor),
+			grpc.StreamInterceptor(c.streamInterceptor),
+		)
+	}
+
+	return opts, nil
+}
+
+func (c *Config) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := c.authorizeRPC(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (c *Config) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := c.authorizeRPC(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
+}
+
+// authorizeRPC finds who made the request and checks they can use the RPC. The returned context
+// carries their identity.
+func (c *Config) authorizeRPC(ctx context.Context, fullMethod string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var authorization string
+	if values := md.Get(authorizationKey); len(values) > 0 {
+		authorization = values[0]
+	}
+
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &info.State
+		}
+	}
+
+	id, err := c.Authenticate(authorization, state)
+	if err != nil {
+		return nil, err
+	}
+
+	// Full methods look like /models.Leader/Announce.
+	if err := id.Authorize(path.Base(fullMethod)); err != nil {
+		return nil, err
+	}
+
+	return NewContext(ctx, id), nil
+}
+
+// identityStream is a server stream with the identity in it's context.
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
+
+// tokenCredentials sends the token with each RPC.
+type tokenCredentials struct {
+	authorization string
+	secure        bool
+}
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{authorizationKey: t.authorization}, nil
+}
+
+// RequireTransportSecurity only requires TLS when it's on. Tokens are allowed without TLS, for
+// networks where TLS isn't worth setting up, and the leader warns about it when it starts.
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return t.secure
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/polygon-io/go-app-ticker-wall/models"
+	modelsv2 "github.com/polygon-io/go-app-ticker-wall/models/v2"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	tombv2 "gopkg.in/tomb.v2"
@@ -22,6 +23,7 @@ type Client interface {
 	GetAnnouncements() chan *models.Announcement
 	GetStatus() *Status
 	UpdateScreen(width, height int)
+	Now() time.Time
 }
 
 const maxMessageSize = 1024 * 1024 * 1 // 1MB
@@ -31,8 +33,9 @@ type ClusterClient struct {
 	sync.RWMutex
 	config Config
 
-	conn   *grpc.ClientConn
-	client models.LeaderClient
+	conn     *grpc.ClientConn
+	client   models.LeaderClient
+	clientV2 modelsv2.LeaderClient
 
 	// State which will be kept in sync.
 	Screen  *models.Screen
@@ -42,7 +45,17 @@ type ClusterClient struct {
 	// Announcements is a channel of announcements to display.
 	Announcements chan *models.Announcement
 
+	// The sequence number of the last update of our wall we have applied.
+	sequence int64
+
+	// Announcements which have been queued for display, and when they can be forgotten.
+	shownAnnouncements map[announcementKey]int64
+
 	Status *Status
+
+	// Our clocks offset from the leaders clock, and the round trip it was measured with.
+	clockOffset    time.Duration
+	clockRoundTrip time.Duration
 }
 
 // New creates a new ticker wall client.
@@ -57,8 +70,20 @@ func New(cfg Config) (*ClusterClient, error) {
 			Width:  int32(cfg.ScreenWidth),
 			Height: int32(cfg.ScreenHeight),
 			Index:  int32(cfg.ScreenIndex),
+			Row:    int32(cfg.ScreenRow),
+			Wall:   models.WallName(cfg.Wall),
+
+			Observer: cfg.Observer,
+
+			BezelLeft:  int32(cfg.BezelLeft),
+			BezelRight: int32(cfg.BezelRight),
+			Gap:        int32(cfg.Gap),
+
+			// The newest protocol we speak.
+			ProtocolVersion: models.ProtocolV2,
 		},
-		Announcements: make(chan *models.Announcement, 100),
+		Announcements:      make(chan *models.Announcement, 100),
+		shownAnnouncements: map[announcementKey]int64{},
 	}
 
 	return obj, nil
@@ -92,17 +117,18 @@ func (t *ClusterClient) Run(ctx context.Context) error {
 		return t.joinCluster(ctx)
 	})
 
+	// Keep our clock in sync with the leader.
+	tomb.Go(func() error {
+		return t.clockSyncLoop(ctx)
+	})
+
 	return tomb.Wait()
 }
 
 func (t *ClusterClient) joinCluster(ctx context.Context) error {
-	// Load in all ticker details.
-	if err := t.LoadTickers(ctx); err != nil {
-		return err
-	}
-
-	// Join cluster, get read stream ( updateListener ) of events.
-	updateListener, err := t.client.JoinCluster(ctx, t.Screen)
+	// Join cluster, get read stream ( updateListener ) of events. The leader starts the stream with
+	// a snapshot of our wall, which replaces whatever we had.
+	updateListener, err := t.joinStream(ctx)
 	if err != nil {
 		return err
 	}
@@ -131,7 +157,7 @@ func (t *ClusterClient) joinCluster(ctx context.Context) error {
 			continue
 		}
 
-		if err := t.processUpdate(update); err != nil {
+		if err := t.applyUpdate(ctx, update); err != nil {
 			return err
 		}
 	}
@@ -189,9 +215,13 @@ func (t *ClusterClient) Close() error {
 func (t *ClusterClient) startGRPCClient() error {
 	logrus.Debug("Connect to gRPC Leader.")
 
-	var opts []grpc.DialOption
+	// TLS and token.
+	opts, err := t.config.Auth.DialOptions()
+	if err != nil {
+		return fmt.Errorf("unable to secure connection to leader: %w", err)
+	}
+
 	opts = append(opts,
-		grpc.WithInsecure(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize)),
 		grpc.WithBlock(),
 		grpc.WithTimeout(5*time.Second),
@@ -207,6 +237,7 @@ func (t *ClusterClient) startGRPCClient() error {
 	// Set our attributes.
 	t.conn = conn
 	t.client = models.NewLeaderClient(t.conn)
+	t.clientV2 = modelsv2.NewLeaderClient(t.conn)
 
 	logrus.Debug("Created new gRPC client to Leader.")
 
@@ -217,6 +248,11 @@ func (t *ClusterClient) startGRPCClient() error {
 func (t *ClusterClient) updateAnnouncement(announcement *models.Announcement) error {
 	logrus.Debug("Got announcement.. ", announcement)
 
+	// We may already have it, if it was in a snapshot.
+	if !t.firstShowing(announcement) {
+		return nil
+	}
+
 	// Put the announcement into the queue for display.
 	t.Announcements <- announcement
 
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// clockSyncInterval is how often we measure our clock offset from the leader.
+	clockSyncInterval = 10 * time.Second
+	// clockSyncSamples is how many measurements we take each time, we use the one with the fastest
+	// round trip since it has the least network noise.
+	clockSyncSamples = 5
+)
+
+// Now returns the current time on the leaders clock. All screens should use this for anything
+// which needs to line up across the cluster ( scrolling, announcements ).
+func (t *ClusterClient) Now() time.Time {
+	t.RLock()
+	defer t.RUnlock()
+
+	return time.Now().Add(t.clockOffset)
+}
+
+// clockSyncLoop regularly measures our clock offset from the leader.
+func (t *ClusterClient) clockSyncLoop(ctx context.Context) error {
+	timer1 := time.NewTicker(clockSyncInterval)
+	defer timer1.Stop()
+
+	for {
+		if err := t.syncClock(ctx); err != nil {
+			logrus.WithError(err).Debug("Unable to sync clock with leader.")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer1.C:
+		}
+	}
+}
+
+// syncClock estimates our clock offset from the leader, NTP style.
+func (t *ClusterClient) syncClock(ctx context.Context) error {
+	t.RLock()
+	req := &models.ClockSyncRequest{
+		ScreenUUID:       t.Screen.UUID,
+		ClockOffsetNS:    int64(t.clockOffset),
+		ClockRoundTripNS: int64(t.clockRoundTrip),
+	}
+	t.RUnlock()
+
+	var bestOffset, bestRoundTrip time.Duration
+	for i := 0; i < clockSyncSamples; i++ {
+		req.ClientTransmitNS = time.Now().UnixNano()
+
+		res, err := t.client.SyncClock(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		receivedAt := time.Now().UnixNano()
+
+		// Round trip, minus the time the leader spent handling the request.
+		roundTrip := time.Duration((receivedAt - res.ClientTransmitNS) - (res.LeaderTransmitNS - res.LeaderReceiveNS))
+		// Assumes the request and response took the same amount of time.
+		offset := time.Duration(((res.LeaderReceiveNS - res.ClientTransmitNS) + (res.LeaderTransmitNS - receivedAt)) / 2)
+
+		if i == 0 || roundTrip < bestRoundTrip {
+			bestOffset, bestRoundTrip = offset, roundTrip
+		}
+	}
+
+	t.Lock()
+	t.clockOffset = bestOffset
+	t.clockRoundTrip = bestRoundTrip
+	t.Unlock()
+
+	// The leader only hears about our offset when we send it, report the new one right away rather
+	// than with the next sync.
+	req.ClientTransmitNS = time.Now().UnixNano()
+	req.ClockOffsetNS = int64(bestOffset)
+	req.ClockRoundTripNS = int64(bestRoundTrip)
+	if _, err := t.client.SyncClock(ctx, req); err != nil {
+		return err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"offset":    bestOffset,
+		"roundTrip": bestRoundTrip,
+	}).Debug("Synced clock with leader.")
+
+	return nil
+}
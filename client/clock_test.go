@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/grpc"
+)
+
+// clockLeader is a leader whose clock is ahead of ours by offset. It keeps the offsets screens
+// report, like the real leader.
+type clockLeader struct {
+	models.LeaderClient
+	offset   time.Duration
+	reported []int64
+}
+
+func (l *clockLeader) SyncClock(ctx context.Context, req *models.ClockSyncRequest, opts ...grpc.CallOption) (*models.ClockSyncResponse, error) {
+	l.reported = append(l.reported, req.ClockOffsetNS)
+	now := time.Now().Add(l.offset).UnixNano()
+	return &models.ClockSyncResponse{
+		ClientTransmitNS: req.ClientTransmitNS,
+		LeaderReceiveNS:  now,
+		LeaderTransmitNS: now,
+	}, nil
+}
+
+func TestSyncClockReportsTheNewOffset(t *testing.T) {
+	leader := &clockLeader{offset: time.Second}
+	c, _ := New(Config{})
+	c.client = leader
+
+	if err := c.syncClock(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The leader is told about the offset as soon as it's measured, not with the next sync.
+	reported := time.Duration(leader.reported[len(leader.reported)-1])
+	if reported < time.Second-10*time.Millisecond || reported > time.Second+10*time.Millisecond {
+		t.Errorf("reported offset = %v, want about 1s", reported)
+	}
+	if offset := c.Now().Sub(time.Now()); offset < 990*time.Millisecond {
+		t.Errorf("clock offset = %v, want about 1s", offset)
+	}
+}
@@ -11,4 +11,19 @@ func (t *ClusterClient) updateScreenCluster(cluster *models.ScreenCluster) {
 	defer t.Unlock()
 
 	t.Cluster = cluster
+
+	// Our layout may have been changed by someone else ( eg: tickerwall screens set ), keep it so
+	// our own screen updates don't revert it.
+	for _, screen := range cluster.Screens {
+		if screen.UUID != t.Screen.UUID {
+			continue
+		}
+
+		t.Screen.Index = screen.Index
+		t.Screen.Row = screen.Row
+		t.Screen.BezelLeft = screen.BezelLeft
+		t.Screen.BezelRight = screen.BezelRight
+		t.Screen.Gap = screen.Gap
+		break
+	}
 }
@@ -1,10 +1,24 @@
 package client
 
+import "github.com/polygon-io/go-app-ticker-wall/auth"
+
 type Config struct {
 	Leader string
+	// Auth secures the connection to the leader.
+	Auth auth.Config
+	// Wall is which wall of the cluster to join, empty is the default wall.
+	Wall string
+	// Observer clients receive all of the walls updates, but are not part of the screen layout.
+	Observer bool
 
 	// Local Presentation Settings:
 	ScreenWidth  int
 	ScreenHeight int
 	ScreenIndex  int
+	ScreenRow    int
+
+	// Physical layout of the screen, in pixels.
+	BezelLeft  int
+	BezelRight int
+	Gap        int
 }
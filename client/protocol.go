@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	modelsv2 "github.com/polygon-io/go-app-ticker-wall/models/v2"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// updateStream is a stream of updates from the leader, whichever protocol it's sent with.
+type updateStream interface {
+	Recv() (*models.Update, error)
+}
+
+// v2Stream reads a v2 stream as v1 updates.
+type v2Stream struct {
+	stream modelsv2.Leader_JoinClusterClient
+}
+
+func (s v2Stream) Recv() (*models.Update, error) {
+	update, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return update.V1(), nil
+}
+
+// joinStream joins our wall with the newest protocol the leader supports. Leaders from before the
+// v2 protocol don't have it's JoinCluster, so we fall back to v1 for them.
+func (t *ClusterClient) joinStream(ctx context.Context) (updateStream, error) {
+	stream, err := t.clientV2.JoinCluster(ctx, t.Screen)
+	if err != nil {
+		return nil, err
+	}
+
+	// The handshake is the first update. Errors, like the RPC not existing, come with it.
+	first, err := stream.Recv()
+	if status.Code(err) == codes.Unimplemented {
+		logrus.Warn("Leader doesn't support protocol v2, using v1.")
+		t.setProtocolVersion(models.ProtocolV1)
+		return t.client.JoinCluster(ctx, t.Screen)
+	} else if err != nil {
+		return nil, err
+	}
+
+	handshake := first.GetHandshake()
+	if handshake == nil {
+		return nil, errors.New("leader didn't start the update stream with a handshake")
+	}
+	if handshake.ProtocolVersion != models.ProtocolV2 {
+		return nil, fmt.Errorf("leader wants to use protocol v%d, we only support v%d and v%d",
+			handshake.ProtocolVersion, models.ProtocolV1, models.ProtocolV2)
+	}
+
+	t.setProtocolVersion(models.ProtocolV2)
+	return v2Stream{stream: stream}, nil
+}
+
+// setProtocolVersion records the protocol the leader is sending our updates with.
+func (t *ClusterClient) setProtocolVersion(version int32) {
+	t.Lock()
+	defer t.Unlock()
+
+	t.Status.ProtocolVersion = version
+}
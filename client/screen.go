@@ -3,7 +3,9 @@ package client
 import (
 	"context"
 
+	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 )
 
 func (t *ClusterClient) UpdateScreen(width, height int) {
@@ -22,5 +24,9 @@ func (t *ClusterClient) UpdateScreen(width, height int) {
 }
 
 func (t *ClusterClient) broadcastScreenUpdate() {
-	t.client.UpdateScreen(context.Background(), t.Screen)
+	t.RLock()
+	screen := proto.Clone(t.Screen).(*models.Screen)
+	t.RUnlock()
+
+	t.client.UpdateScreen(context.Background(), screen)
 }
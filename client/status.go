@@ -2,6 +2,12 @@ package client
 
 type Status struct {
 	GRPCStatus GRPCStatus
+
+	// ProtocolVersion is the protocol the leader is sending our updates with.
+	ProtocolVersion int32
+
+	// Resyncs is how many times we missed updates, and caught up with a snapshot of our wall.
+	Resyncs int
 }
 
 // GRPCStatus defines the current status of the given gRPC connection.
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+)
+
+// announcementKey identifies an announcement, announcements don't have an ID.
+type announcementKey struct {
+	showAt  int64
+	message string
+}
+
+// applyUpdate applies an update from our walls stream. The stream starts with a snapshot of the
+// wall, and the updates after it are numbered, each one more than the last. When we miss some we
+// catch up with a new snapshot from the leader, so we always end up with the walls state.
+func (t *ClusterClient) applyUpdate(ctx context.Context, update *models.Update) error {
+	if models.UpdateType(update.UpdateType) == models.UpdateTypeSnapshot {
+		return t.applySnapshot(update.Snapshot)
+	}
+
+	// Price updates aren't numbered, a newer price replaces any we missed.
+	if update.Sequence == 0 {
+		return t.processUpdate(update)
+	}
+
+	t.RLock()
+	sequence := t.sequence
+	t.RUnlock()
+
+	switch {
+	case update.Sequence <= sequence:
+		// Already part of the last snapshot.
+		return nil
+	case sequence != 0 && update.Sequence == sequence+1:
+		return t.processSequenced(update)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"expected": sequence + 1,
+		"got":      update.Sequence,
+	}).Warn("Missed updates from leader, resyncing.")
+
+	snapshot, err := t.client.GetSnapshot(ctx, &models.WallRequest{Wall: t.Screen.Wall})
+	if err != nil {
+		// We are still behind, so the next update will try again.
+		logrus.WithError(err).Error("Unable to resync with leader.")
+		return nil
+	}
+
+	t.Lock()
+	t.Status.Resyncs++
+	t.Unlock()
+
+	if err := t.applySnapshot(snapshot); err != nil {
+		return err
+	}
+
+	// The snapshot is taken after the update was numbered, so it should already be part of it.
+	if update.Sequence <= snapshot.Sequence {
+		return nil
+	}
+	return t.processSequenced(update)
+}
+
+// processSequenced processes a numbered update, which is the next one we need.
+func (t *ClusterClient) processSequenced(update *models.Update) error {
+	if err := t.processUpdate(update); err != nil {
+		return err
+	}
+
+	t.Lock()
+	t.sequence = update.Sequence
+	t.Unlock()
+
+	return nil
+}
+
+// applySnapshot replaces our state with a snapshot of our wall.
+func (t *ClusterClient) applySnapshot(snapshot *models.Snapshot) error {
+	if snapshot == nil {
+		return errors.New("snapshot update is missing it's snapshot")
+	}
+
+	// Get the tickers ready before swapping them in, so they are never seen half done.
+	tickers := snapshot.Tickers
+	for _, ticker := range tickers {
+		setTickerPrice(ticker, ticker.Price)
+	}
+	sortAndTag(tickers)
+
+	t.Lock()
+	t.Tickers = tickers
+	t.sequence = snapshot.Sequence
+	t.Unlock()
+
+	t.updateScreenCluster(snapshot.ScreenCluster)
+
+	// Announcements we already have are skipped.
+	for _, announcement := range snapshot.Announcements {
+		if err := t.updateAnnouncement(announcement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// firstShowing remembers the announcement, and returns false when we have already queued it for
+// display.
+func (t *ClusterClient) firstShowing(announcement *models.Announcement) bool {
+	now := t.Now().UnixMilli()
+
+	t.Lock()
+	defer t.Unlock()
+
+	// Forget announcements which are long done, the leader won't send them again.
+	for key, forgetAt := range t.shownAnnouncements {
+		if forgetAt < now {
+			delete(t.shownAnnouncements, key)
+		}
+	}
+
+	key := announcementKey{showAt: announcement.ShowAtTimestampMS, message: announcement.Message}
+	if _, ok := t.shownAnnouncements[key]; ok {
+		return false
+	}
+	t.shownAnnouncements[key] = announcement.ShowAtTimestampMS + announcement.LifespanMS + time.Minute.Milliseconds()
+
+	return true
+}
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/grpc"
+)
+
+// snapshotLeader is a leader which only serves snapshots.
+type snapshotLeader struct {
+	models.LeaderClient
+	snapshot  *models.Snapshot
+	snapshots int
+}
+
+func (l *snapshotLeader) GetSnapshot(ctx context.Context, req *models.WallRequest, opts ...grpc.CallOption) (*models.Snapshot, error) {
+	l.snapshots++
+	return l.snapshot, nil
+}
+
+func tickerUpdate(sequence int64, symbol string) *models.Update {
+	return &models.Update{
+		UpdateType: int32(models.UpdateTypeTickerAdded),
+		Ticker:     &models.Ticker{Ticker: symbol},
+		Sequence:   sequence,
+	}
+}
+
+func symbols(tickers []*models.Ticker) []string {
+	var res []string
+	for _, ticker := range tickers {
+		res = append(res, ticker.Ticker)
+	}
+	return res
+}
+
+func TestApplyUpdateResyncsOnGap(t *testing.T) {
+	ctx := context.Background()
+	leader := &snapshotLeader{}
+	c, _ := New(Config{})
+	c.client = leader
+
+	// The stream starts with a snapshot.
+	err := c.applyUpdate(ctx, &models.Update{
+		UpdateType: int32(models.UpdateTypeSnapshot),
+		Snapshot: &models.Snapshot{
+			Sequence:      10,
+			ScreenCluster: &models.ScreenCluster{},
+			Tickers:       []*models.Ticker{{Ticker: "AAPL"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Updates already in the snapshot are skipped, the next one is applied.
+	for _, update := range []*models.Update{tickerUpdate(9, "OLD"), tickerUpdate(11, "AMD")} {
+		if err := c.applyUpdate(ctx, update); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := symbols(c.GetTickers()); len(got) != 2 || got[0] != "AAPL" || got[1] != "AMD" {
+		t.Fatalf("tickers = %v, want AAPL and AMD", got)
+	}
+	if leader.snapshots != 0 {
+		t.Fatalf("resynced %d times without a gap", leader.snapshots)
+	}
+
+	// Update 12 removed AMD and added MSFT, but we missed it.
+	leader.snapshot = &models.Snapshot{
+		Sequence:      13,
+		ScreenCluster: &models.ScreenCluster{},
+		Tickers:       []*models.Ticker{{Ticker: "MSFT"}, {Ticker: "AAPL"}},
+	}
+	if err := c.applyUpdate(ctx, tickerUpdate(13, "MSFT")); err != nil {
+		t.Fatal(err)
+	}
+	if leader.snapshots != 1 || c.GetStatus().Resyncs != 1 {
+		t.Fatalf("resynced %d times, want once", leader.snapshots)
+	}
+	got := c.GetTickers()
+	if names := symbols(got); len(names) != 2 || names[0] != "AAPL" || names[1] != "MSFT" {
+		t.Fatalf("tickers = %v, want the snapshots", names)
+	}
+	if got[1].Index != 1 {
+		t.Errorf("MSFT index = %d, want 1", got[1].Index)
+	}
+
+	// Price updates aren't numbered, and never cause a resync.
+	err = c.applyUpdate(ctx, &models.Update{
+		UpdateType:  int32(models.UpdateTypePrice),
+		PriceUpdate: &models.PriceUpdate{Ticker: "AAPL", Price: 100},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if leader.snapshots != 1 || got[0].Price != 100 {
+		t.Errorf("price update resynced or wasn't applied, price = %v", got[0].Price)
+	}
+}
+
+func TestSnapshotSkipsShownAnnouncements(t *testing.T) {
+	c, _ := New(Config{})
+	announcement := &models.Announcement{Message: "Hello", ShowAtTimestampMS: c.Now().UnixMilli(), LifespanMS: 1000}
+
+	if err := c.applyUpdate(context.Background(), &models.Update{
+		UpdateType:   int32(models.UpdateTypeAnnouncement),
+		Announcement: announcement,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A snapshot taken after the announcement still has it.
+	if err := c.applySnapshot(&models.Snapshot{
+		ScreenCluster: &models.ScreenCluster{},
+		Announcements: []*models.Announcement{announcement},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if queued := len(c.Announcements); queued != 1 {
+		t.Errorf("queued %d announcements, want 1", queued)
+	}
+}
@@ -13,17 +13,22 @@ func (t *ClusterClient) tickerPriceUpdate(update *models.PriceUpdate) error {
 	t.Lock()
 	defer t.Unlock()
 
-	for _, t := range t.Tickers {
-		if t.Ticker == update.Ticker {
-			t.Price = update.Price
-			t.MarketCap = float64(t.OutstandingShares) * update.Price
-			t.PriceChangePercentage = ((t.Price / t.PreviousClosePrice) - 1) * 100
+	for _, ticker := range t.Tickers {
+		if ticker.Ticker == update.Ticker {
+			setTickerPrice(ticker, update.Price)
 		}
 	}
 
 	return nil
 }
 
+// setTickerPrice sets a tickers price, and the market cap & change which depend on it.
+func setTickerPrice(ticker *models.Ticker, price float64) {
+	ticker.Price = price
+	ticker.MarketCap = float64(ticker.OutstandingShares) * price
+	ticker.PriceChangePercentage = ((price / ticker.PreviousClosePrice) - 1) * 100
+}
+
 // tickerAdded handles adding a ticker to our local state.
 func (t *ClusterClient) tickerAdded(ticker *models.Ticker) error {
 	t.Lock()
@@ -91,7 +96,7 @@ func (t *ClusterClient) tickerRemoved(ticker *models.Ticker) error {
 // LoadTickers requests the full list of tickers from leader.
 func (t *ClusterClient) LoadTickers(ctx context.Context) error {
 	// Request full list of tickers from the leader.
-	tickers, err := t.client.GetTickers(ctx, &models.Empty{})
+	tickers, err := t.client.GetTickers(ctx, &models.WallRequest{Wall: t.Screen.Wall})
 	if err != nil {
 		return err
 	}
@@ -109,11 +114,16 @@ func (t *ClusterClient) sortAndTagTickers() {
 	t.Lock()
 	defer t.Unlock()
 
+	sortAndTag(t.Tickers)
+}
+
+// sortAndTag sorts the tickers, and tags each with it's position.
+func sortAndTag(tickers []*models.Ticker) {
 	// Sort tickers (asc).
-	sort.Sort(models.TickerSlice(t.Tickers))
+	sort.Sort(models.TickerSlice(tickers))
 
 	// Tag each ticker with it's Index.
-	for i, ticker := range t.Tickers {
+	for i, ticker := range tickers {
 		ticker.Index = int32(i)
 	}
 }
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func newAccessCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: `Show who can use a currently running cluster.`,
+		Long:  `Show the roles and users of a currently running cluster, and what each role can do. Needs the admin role.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			access, err := leaderClient.client.GetAccessControl(context.Background(), &models.Empty{})
+			if err != nil {
+				return err
+			}
+
+			printAccessControl(access)
+
+			return nil
+		},
+	}
+
+	// Dont auto sort flags.
+	cmd.Flags().SortFlags = false
+
+	return cmd
+}
+
+// printAccessControl prints out the roles and users.
+func printAccessControl(access *models.AccessControl) {
+	if !access.Enabled {
+		fmt.Println("Access control is off, everyone can do everything.")
+		return
+	}
+
+	defaultRole := access.DefaultRole
+	if defaultRole == "" {
+		defaultRole = "none, a token is required"
+	}
+	fmt.Println("Default Role:", defaultRole)
+
+	fmt.Println("Roles:")
+	for _, role := range access.Roles {
+		builtIn := ""
+		if role.BuiltIn {
+			builtIn = " ( built in )"
+		}
+		fmt.Println(" ------------ ")
+		fmt.Println(" Role:", role.Name+builtIn)
+		fmt.Println(" - Permissions:", strings.Join(role.Permissions, ", "))
+	}
+	fmt.Println(" ------------ ")
+
+	fmt.Println("Users:")
+	for _, user := range access.Users {
+		var signIn []string
+		if user.Token {
+			signIn = append(signIn, "token")
+		}
+		if user.Certificate != "" {
+			signIn = append(signIn, "certificate "+user.Certificate)
+		}
+		fmt.Println(" - ", user.Name, " [ ", user.Role, " ] signs in with", strings.Join(signIn, " or "))
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"time"
 
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
@@ -15,6 +16,7 @@ func newAnnounceCmd() *cobra.Command {
 	announcement := &models.Announcement{}
 	var announcementType string
 	var announcementAnimation string
+	var delay time.Duration
 
 	cmd := &cobra.Command{
 		Use:   "announce [string to announce]",
@@ -24,7 +26,8 @@ func newAnnounceCmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// Create a leader client...
 			leader, _ := cmd.Flags().GetString("leader")
-			leaderClient, err = NewServerClient(leader)
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
 			if err != nil {
 				return err
 			}
@@ -33,11 +36,21 @@ func newAnnounceCmd() *cobra.Command {
 			announcement.Animation = int32(getAnnouncementAnimation(announcementAnimation))
 			announcement.AnnouncementType = int32(getAnnouncementType(announcementType))
 			announcement.Message = args[0]
+			announcement.Wall = wall
+
+			// Scheduled announcements are held by the leader until it's time to show them.
+			if delay > 0 {
+				announcement.ShowAtTimestampMS = time.Now().Add(delay).UnixMilli()
+			}
 
 			if _, err = leaderClient.client.Announce(context.Background(), announcement); err != nil {
 				return err
 			}
 
+			if delay > 0 {
+				logrus.Info("Announcement Scheduled.")
+				return nil
+			}
 			logrus.Info("Announcement Sent.")
 
 			return nil
@@ -48,6 +61,7 @@ func newAnnounceCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&announcementType, "type", "t", "info", "Announcement type. This determines the colors of the announcement. Valid options: ( info, danger, success )")
 	cmd.Flags().StringVarP(&announcementAnimation, "animation", "n", "elastic", "Announcement animation. Valid options: ( elastic, ease, back, bounce )")
 	cmd.Flags().Int64VarP(&announcement.LifespanMS, "lifespan", "i", 2000, "How long the message will be displayed on the ticker wall, in milliseconds.")
+	cmd.Flags().DurationVarP(&delay, "in", "", 0, "Schedule the announcement to be shown after a delay, eg: 15m. Shown right away by default.")
 
 	// Dont auto sort flags.
 	cmd.Flags().SortFlags = false
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newAuditCmd() *cobra.Command {
+	var leaderClient *ServerClient
+	req := &models.AuditLogRequest{}
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: `Show who changed what on a currently running cluster.`,
+		Long:  `Show the most recent changes made to the walls of a currently running cluster, oldest first: who made them, when, and the values before and after. Every wall is shown unless --wall is given.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			// The wall flag has a default, only filter by it when it's given.
+			if cmd.Flags().Changed("wall") {
+				req.Wall, _ = cmd.Flags().GetString("wall")
+			}
+
+			log, err := leaderClient.client.GetAuditLog(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			for _, entry := range log.Entries {
+				if asJSON {
+					data, err := protojson.Marshal(entry)
+					if err != nil {
+						return err
+					}
+					fmt.Println(string(data))
+					continue
+				}
+				printAuditEntry(entry)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&req.Action, "action", "", "", "Only show changes made by this RPC, eg: Announce")
+	cmd.Flags().StringVarP(&req.User, "user", "", "", "Only show changes made by this user.")
+	cmd.Flags().Int32VarP(&req.Limit, "limit", "n", 100, "How many of the most recent changes to show.")
+	cmd.Flags().BoolVarP(&asJSON, "json", "", false, "Print each change as a line of JSON, the same as the leader's audit file.")
+
+	// Dont auto sort flags.
+	cmd.Flags().SortFlags = false
+
+	return cmd
+}
+
+// printAuditEntry prints out a change, with the before and after values of the fields which changed.
+func printAuditEntry(entry *models.AuditEntry) {
+	who := "anonymous"
+	if entry.User != "" {
+		who = entry.User + " ( " + entry.Role + " )"
+	}
+
+	when := time.UnixMilli(entry.TimestampMS).Format("2006-01-02 15:04:05")
+	fmt.Printf("%s  %s  %s  wall: %s %s\n", when, who, entry.Action, entry.Wall, entry.Target)
+
+	before, after := auditFields(entry.Before), auditFields(entry.After)
+	switch {
+	case len(entry.Changed) > 0:
+		for _, field := range entry.Changed {
+			fmt.Printf(" - %s: %s -> %s\n", field, before[field], after[field])
+		}
+	case entry.Before != nil && entry.After != nil:
+		fmt.Println(" - Nothing changed.")
+	case entry.After != nil:
+		fmt.Println(" - Added:", compactJSON(entry.After))
+	case entry.Before != nil:
+		fmt.Println(" - Removed:", compactJSON(entry.Before))
+	}
+}
+
+// auditFields are the JSON values of an audited message's fields, by name.
+func auditFields(value *anypb.Any) map[string]json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	if value == nil {
+		return fields
+	}
+
+	msg, err := value.UnmarshalNew()
+	if err != nil {
+		return fields
+	}
+
+	// Unset fields are printed too, since changing a field to it's zero value unsets it.
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return fields
+	}
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+// compactJSON is an audited message as JSON, without it's type.
+func compactJSON(value *anypb.Any) string {
+	msg, err := value.UnmarshalNew()
+	if err != nil {
+		return value.TypeUrl
+	}
+
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return value.TypeUrl
+	}
+	return string(data)
+}
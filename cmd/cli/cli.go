@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -59,19 +60,48 @@ Find out more at: https://github.com/polygon-io/go-app-ticker-wall`,
 	// Global flags.
 	rootCmd.PersistentFlags().StringP("api-key", "a", "", "Your Polygon.io API Key. This key will be used to access Polygon.io for data.")
 	rootCmd.PersistentFlags().StringP("leader", "l", "localhost:6886", "The leaders address of the cluster.")
+	rootCmd.PersistentFlags().StringP("wall", "", models.DefaultWall, "Which wall of the cluster to use, for leaders serving more than one wall.")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "Debug enables more verbose logging.")
 
+	// Security flags, used by the leader and by everything connecting to it.
+	rootCmd.PersistentFlags().StringP("token", "", "", "Bearer token. The leader treats it as an admin and requires a token when it's set, clients send it. Prefer setting it with TW_TOKEN, so it isn't in your shell history.")
+	rootCmd.PersistentFlags().BoolP("tls", "", false, "Use TLS. Implied by the other --tls-* flags, clients use the system CAs unless --tls-ca is set.")
+	rootCmd.PersistentFlags().StringP("tls-cert", "", "", "Certificate file. The leader's own certificate, or a client certificate for leaders which require them.")
+	rootCmd.PersistentFlags().StringP("tls-key", "", "", "Key file of the certificate.")
+	rootCmd.PersistentFlags().StringP("tls-ca", "", "", "CA file used to verify the other side. Leaders given a CA require client certificates signed by it ( mTLS ).")
+
 	// Add additional commands.
 	rootCmd.AddCommand(newGUICmd())
+	rootCmd.AddCommand(newTUICmd())
 	rootCmd.AddCommand(newServerCmd())
 	rootCmd.AddCommand(newUpdateCmd())
 	rootCmd.AddCommand(newAnnounceCmd())
 	rootCmd.AddCommand(newDescribeCmd())
+	rootCmd.AddCommand(newRecordCmd())
+	rootCmd.AddCommand(newTickersCmd())
+	rootCmd.AddCommand(newWallsCmd())
+	rootCmd.AddCommand(newScreensCmd())
+	rootCmd.AddCommand(newAccessCmd())
+	rootCmd.AddCommand(newAuditCmd())
 
 	return rootCmd
 }
 
 func initializeConfig(cmd *cobra.Command) error {
+	v, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+
+	v.SetEnvPrefix(envPrefix)
+	v.AutomaticEnv()
+	bindFlags(cmd, v)
+
+	return nil
+}
+
+// readConfigFile reads the tickerwall config file, if there is one.
+func readConfigFile() (*viper.Viper, error) {
 	v := viper.New()
 
 	v.SetConfigName(defaultConfigFilename)
@@ -86,15 +116,11 @@ func initializeConfig(cmd *cobra.Command) error {
 	if err := v.ReadInConfig(); err != nil {
 		// It's okay if there isn't a config file
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return err
+			return nil, err
 		}
 	}
 
-	v.SetEnvPrefix(envPrefix)
-	v.AutomaticEnv()
-	bindFlags(cmd, v)
-
-	return nil
+	return v, nil
 }
 
 // Bind each cobra flag to its associated viper configuration (config file and environment variable)
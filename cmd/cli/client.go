@@ -4,20 +4,24 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/polygon-io/go-app-ticker-wall/auth"
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 )
 
 type ServerClient struct {
 	Leader string
+	Auth   auth.Config
 	conn   *grpc.ClientConn
 	client models.LeaderClient
 }
 
-func NewServerClient(leader string) (*ServerClient, error) {
+func NewServerClient(leader string, authCfg auth.Config) (*ServerClient, error) {
 	obj := &ServerClient{
 		Leader: leader,
+		Auth:   authCfg,
 	}
 	if err := obj.setup(); err != nil {
 		return nil, err
@@ -37,9 +41,13 @@ const maxMessageSize = 1024 * 1024 * 1 // 1MB
 func (s *ServerClient) startGRPCClient() error {
 	logrus.Debug("Connect to gRPC Leader.")
 
-	var opts []grpc.DialOption
+	// TLS and token.
+	opts, err := s.Auth.DialOptions()
+	if err != nil {
+		return fmt.Errorf("unable to secure connection to leader: %w", err)
+	}
+
 	opts = append(opts,
-		grpc.WithInsecure(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize)),
 		grpc.WithBlock(),
 		grpc.WithTimeout(5*time.Second),
@@ -60,3 +68,14 @@ func (s *ServerClient) startGRPCClient() error {
 
 	return nil
 }
+
+// authConfig reads how to secure the connection to the leader from the flags.
+func authConfig(cmd *cobra.Command) auth.Config {
+	var cfg auth.Config
+	cfg.Token, _ = cmd.Flags().GetString("token")
+	cfg.TLS, _ = cmd.Flags().GetBool("tls")
+	cfg.CertFile, _ = cmd.Flags().GetString("tls-cert")
+	cfg.KeyFile, _ = cmd.Flags().GetString("tls-key")
+	cfg.CAFile, _ = cmd.Flags().GetString("tls-ca")
+	return cfg
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
@@ -19,23 +21,29 @@ func newDescribeCmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// Create a leader client...
 			leader, _ := cmd.Flags().GetString("leader")
-			leaderClient, err = NewServerClient(leader)
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
 			if err != nil {
 				return err
 			}
 			logrus.Debug("Connected to Leader.")
 
-			cluster, err := leaderClient.client.GetScreenCluster(context.Background(), &models.Empty{})
+			cluster, err := leaderClient.client.GetScreenCluster(context.Background(), &models.WallRequest{Wall: wall})
 			if err != nil {
 				return err
 			}
 
-			tickers, err := leaderClient.client.GetTickers(context.Background(), &models.Empty{})
+			tickers, err := leaderClient.client.GetTickers(context.Background(), &models.WallRequest{Wall: wall})
 			if err != nil {
 				return err
 			}
 
-			printClusterInfo(cluster, tickers)
+			walls, err := leaderClient.client.ListWalls(context.Background(), &models.Empty{})
+			if err != nil {
+				return err
+			}
+
+			printClusterInfo(cluster, tickers, walls)
 
 			return nil
 		},
@@ -49,13 +57,22 @@ func newDescribeCmd() *cobra.Command {
 
 // printClusterInfo prints out the clusters details
 // TODO: This would look a lot better as a table or something.
-func printClusterInfo(cluster *models.ScreenCluster, tickers *models.Tickers) {
+func printClusterInfo(cluster *models.ScreenCluster, tickers *models.Tickers, walls *models.Walls) {
+	fmt.Println("Wall:", cluster.Wall, "( all walls:", strings.Join(walls.Walls, ", "), ")")
 	fmt.Println("Global Viewport Size:", cluster.GlobalViewportSize(), "px")
 	fmt.Println("Animation Duration:", cluster.Settings.AnimationDurationMS, "ms")
 	fmt.Println("Scroll Speed:", cluster.Settings.ScrollSpeed)
 	fmt.Println("Ticker Box Width:", cluster.Settings.TickerBoxWidth, "px")
+	fmt.Println("Dynamic Ticker Widths:", cluster.Settings.DynamicTickerWidths)
+	fmt.Println("Tape Width:", cluster.Settings.TapeWidth(len(tickers.Tickers)), "px")
+	fmt.Println("Spacer:", cluster.Settings.SpacerWidth, "px", cluster.Settings.SpacerText)
 	fmt.Println("Per Tick Updates:", cluster.Settings.PerTickUpdates)
 	fmt.Println("Screen Count:", cluster.NumberOfScreens())
+	fmt.Println("Slow Screens Disconnected:", cluster.SlowScreensDisconnected)
+	if cluster.NumberOfScreens() > 0 {
+		fmt.Println("Wall Layout:")
+		printWallGrid(cluster)
+	}
 	fmt.Println("Screen Details:")
 	for _, screen := range cluster.Screens {
 		fmt.Println(" ------------ ")
@@ -63,6 +80,16 @@ func printClusterInfo(cluster *models.ScreenCluster, tickers *models.Tickers) {
 		fmt.Println(" - Width", screen.Width, "px")
 		fmt.Println(" - Height", screen.Height, "px")
 		fmt.Println(" - Index", screen.Index)
+		fmt.Println(" - Row", screen.Row)
+		fmt.Println(" - Bezels", screen.BezelLeft, "px left,", screen.BezelRight, "px right")
+		fmt.Println(" - Gap", screen.Gap, "px")
+		fmt.Printf(" - Clock Skew %.2f ms ( round trip %.2f ms )\n", float64(screen.ClockOffsetNS)/float64(time.Millisecond), float64(screen.ClockRoundTripNS)/float64(time.Millisecond))
+		if stats := screen.SendStats; stats != nil {
+			fmt.Println(" - Updates", stats.Sent, "sent,", stats.Queued, "queued,", stats.Conflated, "conflated,", stats.Dropped, "dropped")
+		}
+		if screen.ProtocolVersion != 0 {
+			fmt.Printf(" - Protocol v%d\n", screen.ProtocolVersion)
+		}
 	}
 	fmt.Println(" ------------ ")
 	fmt.Println("Ticker count:", len(tickers.Tickers))
@@ -72,3 +99,31 @@ func printClusterInfo(cluster *models.ScreenCluster, tickers *models.Tickers) {
 		fmt.Println(" - ", t.Ticker, " [ ", t.CompanyName, " ]")
 	}
 }
+
+// printWallGrid draws the screens as they are laid out on the wall, one line of boxes per row.
+func printWallGrid(cluster *models.ScreenCluster) {
+	const cellWidth = 12
+
+	border := func(cells int) string {
+		return " +" + strings.Repeat(strings.Repeat("-", cellWidth+2)+"+", cells)
+	}
+
+	rows := cluster.Rows()
+	fmt.Println(border(len(rows[0])))
+	for i, row := range rows {
+		indexLine, sizeLine := " |", " |"
+		for _, screen := range row {
+			indexLine += fmt.Sprintf(" %-*s |", cellWidth, fmt.Sprintf("#%d", screen.Index))
+			sizeLine += fmt.Sprintf(" %-*s |", cellWidth, fmt.Sprintf("%dx%d", screen.Width, screen.Height))
+		}
+		fmt.Println(indexLine)
+		fmt.Println(sizeLine)
+
+		// The border below this row also needs to cover the row below it.
+		cells := len(row)
+		if i+1 < len(rows) && len(rows[i+1]) > cells {
+			cells = len(rows[i+1])
+		}
+		fmt.Println(border(cells))
+	}
+}
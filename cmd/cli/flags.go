@@ -5,6 +5,24 @@ import (
 	"github.com/spf13/pflag"
 )
 
+// presentationFlagPaths maps the presentation and color flags to the PresentationSettings field they set.
+// nolint:gochecknoglobals // static lookup table.
+var presentationFlagPaths = map[string]string{
+	"scroll-speed":          "ScrollSpeed",
+	"ticker-box-width":      "TickerBoxWidth",
+	"dynamic-ticker-widths": "DynamicTickerWidths",
+	"animation-duration":    "AnimationDurationMS",
+	"per-tick-updates":      "PerTickUpdates",
+	"scroll-ease":           "ScrollEaseDurationMS",
+	"spacer-width":          "SpacerWidth",
+	"spacer-text":           "SpacerText",
+	"up-color":              "UpColor",
+	"down-color":            "DownColor",
+	"font-color":            "FontColor",
+	"ticker-bg-color":       "TickerBoxBGColor",
+	"bg-color":              "BGColor",
+}
+
 // colorFlags creates a flagset for the color options.
 func colorFlags(colorMap *colorMap) *pflag.FlagSet {
 	colorFlags := pflag.NewFlagSet("color", pflag.ContinueOnError)
@@ -20,8 +38,12 @@ func colorFlags(colorMap *colorMap) *pflag.FlagSet {
 func presentationFlags(presentationSettings *models.PresentationSettings) *pflag.FlagSet {
 	presentationFlags := pflag.NewFlagSet("presentation", pflag.ContinueOnError)
 	presentationFlags.Int32VarP(&presentationSettings.ScrollSpeed, "scroll-speed", "s", 8, "How fast the tickers scroll across the screen. This is inverted so 1 is the fastest possible.")
-	presentationFlags.Int32VarP(&presentationSettings.TickerBoxWidth, "ticker-box-width", "w", 1100, "The size of the ticker box, in pixels.")
+	presentationFlags.Int32VarP(&presentationSettings.TickerBoxWidth, "ticker-box-width", "w", 1100, "The size of the ticker box, in pixels. With dynamic ticker widths, this is the smallest a box can be.")
+	presentationFlags.BoolVarP(&presentationSettings.DynamicTickerWidths, "dynamic-ticker-widths", "", true, "If each ticker box should be sized to fit it's content.")
 	presentationFlags.Int32VarP(&presentationSettings.AnimationDurationMS, "animation-duration", "", 500, "Animation during of notifications, in milliseconds.")
+	presentationFlags.Int32VarP(&presentationSettings.ScrollEaseDurationMS, "scroll-ease", "", 1000, "How long it takes to ease into a new scroll speed, in milliseconds. 0 changes speed instantly.")
+	presentationFlags.Int32VarP(&presentationSettings.SpacerWidth, "spacer-width", "", 0, "Width of the spacer card between repetitions of the tape, in pixels. 0 for no spacer.")
+	presentationFlags.StringVarP(&presentationSettings.SpacerText, "spacer-text", "", "", "Text to show on the spacer card, such as a company name.")
 	presentationFlags.BoolVarP(&presentationSettings.PerTickUpdates, "per-tick-updates", "", true, "If the ticker wall should update on every trade which happens. Setting to false limits it to update 1/sec.")
 	return presentationFlags
 }
@@ -18,6 +18,8 @@ func newGUICmd() *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			leader, _ := cmd.Flags().GetString("leader")
 			cfg.ClientConfig.Leader = leader
+			cfg.ClientConfig.Wall, _ = cmd.Flags().GetString("wall")
+			cfg.ClientConfig.Auth = authConfig(cmd)
 
 			// Actually start the GUI process.
 			if err := gui.Run(cfg); err != nil {
@@ -30,6 +32,10 @@ func newGUICmd() *cobra.Command {
 	cmd.Flags().IntVarP(&cfg.ClientConfig.ScreenHeight, "screen-height", "y", 300, "Height of this GUI window, in pixels.")
 	cmd.Flags().IntVarP(&cfg.ClientConfig.ScreenWidth, "screen-width", "x", 1600, "Width of this GUI window, in pixels.")
 	cmd.Flags().IntVarP(&cfg.ClientConfig.ScreenIndex, "screen-index", "i", 1, "Index of this GUI window in the window array. Eg: First screen: 10, Second screen: 20, and so on. This is an arbitrary number, used for sorting order.")
+	cmd.Flags().IntVarP(&cfg.ClientConfig.ScreenRow, "screen-row", "", 0, "Row of this GUI window, for walls with more than one row of screens. Rows are ordered top to bottom, and each row continues the tape from the row above it.")
+	cmd.Flags().IntVarP(&cfg.ClientConfig.BezelLeft, "bezel-left", "", 0, "Width of the bezel on the left side of this screen, in pixels.")
+	cmd.Flags().IntVarP(&cfg.ClientConfig.BezelRight, "bezel-right", "", 0, "Width of the bezel on the right side of this screen, in pixels.")
+	cmd.Flags().IntVarP(&cfg.ClientConfig.Gap, "gap", "", 0, "Space between this screen and the next one, in pixels.")
 
 	return cmd
 }
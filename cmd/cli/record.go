@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/polygon-io/go-app-ticker-wall/recording"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func newRecordCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "record [file]",
+		Short: `Record the update stream of a running cluster.`,
+		Long: `Record the update stream of a running cluster to a file, until interrupted.
+The recording can be played back using: tickerwall server --replay=[file]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			f, err := os.Create(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to create recording file: %w", err)
+			}
+			defer f.Close()
+
+			writer := recording.NewWriter(f)
+			defer writer.Flush()
+
+			// Stop recording on interrupt.
+			ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+			defer cancel()
+
+			// Start the recording with the current state of every ticker.
+			tickers, err := leaderClient.client.GetTickers(ctx, &models.WallRequest{Wall: wall})
+			if err != nil {
+				return err
+			}
+
+			startedAt := time.Now()
+			for _, ticker := range tickers.Tickers {
+				if err := writer.Write(startedAt, &models.Update{
+					UpdateType: int32(models.UpdateTypeTickerUpdate),
+					Ticker:     ticker,
+				}); err != nil {
+					return err
+				}
+			}
+
+			// Join as an observer, so we are not part of the screen layout.
+			updates, err := leaderClient.client.JoinCluster(ctx, &models.Screen{
+				UUID:     uuid.NewString(),
+				Observer: true,
+				Wall:     wall,
+			})
+			if err != nil {
+				return err
+			}
+
+			logrus.Info("Recording.. Press Ctrl+C to stop.")
+
+			count := 0
+			for {
+				update, err := updates.Recv()
+				if err != nil {
+					// We were interrupted, this is a normal exit.
+					if ctx.Err() != nil {
+						logrus.WithField("updates", count).Info("Recording saved.")
+						return nil
+					}
+					return err
+				}
+
+				if err := writer.Write(time.Now(), update); err != nil {
+					return err
+				}
+				count++
+			}
+		},
+	}
+
+	// Dont auto sort flags.
+	cmd.Flags().SortFlags = false
+
+	return cmd
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func newScreensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "screens",
+		Short: `Manage the screens of a currently running cluster.`,
+		Long:  `Manage the screens of a currently running cluster.`,
+	}
+
+	cmd.AddCommand(newScreensSetCmd())
+	cmd.AddCommand(newScreensKickCmd())
+
+	return cmd
+}
+
+func newScreensSetCmd() *cobra.Command {
+	var leaderClient *ServerClient
+	layout := &models.Screen{}
+
+	cmd := &cobra.Command{
+		Use:   "set [uuid|index]",
+		Short: `Change the layout of a screen in the cluster.`,
+		Long:  `Change the layout of a screen in the cluster. The screen can be selected by it's UUID or it's index. Only the flags given are changed.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			cluster, err := leaderClient.client.GetScreenCluster(context.Background(), &models.WallRequest{Wall: wall})
+			if err != nil {
+				return err
+			}
+
+			screen, err := findScreen(cluster, args[0])
+			if err != nil {
+				return err
+			}
+
+			// Only change what was asked for.
+			changed := false
+			cmd.Flags().Visit(func(f *pflag.Flag) {
+				switch f.Name {
+				case "index":
+					screen.Index = layout.Index
+				case "row":
+					screen.Row = layout.Row
+				case "bezel-left":
+					screen.BezelLeft = layout.BezelLeft
+				case "bezel-right":
+					screen.BezelRight = layout.BezelRight
+				case "gap":
+					screen.Gap = layout.Gap
+				default:
+					return
+				}
+				changed = true
+			})
+			if !changed {
+				return errors.New("no screen settings to update, see --help for the available flags")
+			}
+
+			screen, err = leaderClient.client.UpdateScreen(context.Background(), screen)
+			if err != nil {
+				return err
+			}
+
+			logrus.Info("Updated screen ", screen.UUID)
+
+			return nil
+		},
+	}
+
+	// Dont auto sort flags.
+	cmd.Flags().SortFlags = false
+
+	cmd.Flags().Int32VarP(&layout.Index, "index", "i", 0, "Index of the screen in the window array, used for sorting order.")
+	cmd.Flags().Int32VarP(&layout.Row, "row", "", 0, "Row of the screen, for walls with more than one row of screens.")
+	cmd.Flags().Int32VarP(&layout.BezelLeft, "bezel-left", "", 0, "Width of the bezel on the left side of the screen, in pixels.")
+	cmd.Flags().Int32VarP(&layout.BezelRight, "bezel-right", "", 0, "Width of the bezel on the right side of the screen, in pixels.")
+	cmd.Flags().Int32VarP(&layout.Gap, "gap", "", 0, "Space between the screen and the next one, in pixels.")
+
+	return cmd
+}
+
+func newScreensKickCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "kick [uuid|index]",
+		Short: `Disconnect a screen from the cluster.`,
+		Long:  `Disconnect a screen from the cluster. The screen can be selected by it's UUID or it's index. Screens reconnect on their own, remove their access to keep them out.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			cluster, err := leaderClient.client.GetScreenCluster(context.Background(), &models.WallRequest{Wall: wall})
+			if err != nil {
+				return err
+			}
+
+			screen, err := findScreen(cluster, args[0])
+			if err != nil {
+				return err
+			}
+
+			screen, err = leaderClient.client.KickScreen(context.Background(), screen)
+			if err != nil {
+				return err
+			}
+
+			logrus.Info("Kicked screen ", screen.UUID)
+
+			return nil
+		},
+	}
+
+	// Dont auto sort flags.
+	cmd.Flags().SortFlags = false
+
+	return cmd
+}
+
+// findScreen finds a screen in the cluster by it's UUID or index.
+func findScreen(cluster *models.ScreenCluster, selector string) (*models.Screen, error) {
+	for _, screen := range cluster.Screens {
+		if screen.UUID == selector {
+			return screen, nil
+		}
+	}
+
+	index, err := strconv.ParseInt(selector, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("no screen with UUID %s", selector)
+	}
+
+	var found *models.Screen
+	for _, screen := range cluster.Screens {
+		if screen.Index != int32(index) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("more than one screen has index %d, use the UUID instead", index)
+		}
+		found = screen
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no screen with index %d", index)
+	}
+	return found, nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	"strings"
 
 	"github.com/polygon-io/go-app-ticker-wall/leader"
 	"github.com/polygon-io/go-app-ticker-wall/models"
@@ -17,6 +18,7 @@ func newServerCmd() *cobra.Command {
 		},
 	}
 	colorMap := &colorMap{}
+	var allowedOrigins string
 
 	cmd := &cobra.Command{
 		Use:   "server",
@@ -29,8 +31,26 @@ func newServerCmd() *cobra.Command {
 			apiKey, _ := cmd.Flags().GetString("api-key")
 			cfg.LeaderConfig.APIKey = apiKey
 
-			if cfg.LeaderConfig.APIKey == "" {
-				logrus.Error("You must set a Polygon.io API Key. Use the '-a' param to set the key. Eg: tickerwall server -a MY_API_KEY.")
+			// Secure the gRPC and HTTP servers.
+			cfg.Auth = authConfig(cmd)
+			if allowedOrigins != "" {
+				cfg.Auth.AllowedOrigins = strings.Split(allowedOrigins, ",")
+			}
+
+			// Users and roles are only in the config file, they don't fit in flags.
+			v, err := readConfigFile()
+			if err != nil {
+				logrus.WithError(err).Error("Unable to read config file.")
+				os.Exit(1)
+			}
+			if err := v.UnmarshalKey("auth", &cfg.Auth.Access); err != nil {
+				logrus.WithError(err).Error("Invalid auth section in config file.")
+				os.Exit(1)
+			}
+
+			// Only the Polygon.io data source needs an API key, replays don't use a data source.
+			if cfg.LeaderConfig.Source == leader.SourcePolygon && cfg.LeaderConfig.ReplayFile == "" && cfg.LeaderConfig.APIKey == "" {
+				logrus.Error("You must set a Polygon.io API Key. Use the '-a' param to set the key. Eg: tickerwall server -a MY_API_KEY. Or use '--source=sim' for simulated data.")
 				os.Exit(1)
 			}
 
@@ -43,6 +63,23 @@ func newServerCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&cfg.LeaderConfig.TickerList, "tickers", "t", "AAPL,AMD,NVDA,SBUX,META,HOOD", "A comma separated list of tickers to display on the ticker wall.")
+	cmd.Flags().StringVarP(&cfg.LeaderConfig.WallList, "walls", "", "", "A comma separated list of walls to serve, besides the default wall. Each starts as a copy of the default wall. More can be created with 'tickerwall walls create'.")
+
+	// Data source.
+	cmd.Flags().StringVarP(&cfg.LeaderConfig.Source, "source", "", leader.SourcePolygon, "Where market data comes from. Valid options: ( polygon, sim ). 'sim' generates random data and does not require an API key.")
+	cmd.Flags().Int64VarP(&cfg.LeaderConfig.Seed, "seed", "", 0, "Seed for the simulated data source, use the same seed to get the same data. 0 picks a random seed.")
+
+	// Replay.
+	cmd.Flags().StringVarP(&cfg.LeaderConfig.ReplayFile, "replay", "", "", "Replay a recording ( see 'tickerwall record' ) instead of using live data.")
+	cmd.Flags().Float64VarP(&cfg.LeaderConfig.ReplaySpeed, "replay-speed", "", 1, "Speed multiplier of the replay. Eg: 2 plays the recording twice as fast.")
+	cmd.Flags().BoolVarP(&cfg.LeaderConfig.ReplayLoop, "replay-loop", "", false, "Start the replay over again when it reaches the end of the recording.")
+
+	// State.
+	cmd.Flags().StringVarP(&cfg.LeaderConfig.StateFile, "state-file", "", "", "File the leader saves its state to ( settings, tickers, announcements ), so it's restored after a restart. Disabled when empty.")
+	cmd.Flags().StringVarP(&cfg.LeaderConfig.AuditFile, "audit-file", "", "", "File the leader appends every change made to the walls to, as JSON lines ( see 'tickerwall audit' ). Only the most recent changes are kept, in memory, when empty.")
+
+	// Browsers.
+	cmd.Flags().StringVarP(&allowedOrigins, "allowed-origins", "", "", "A comma separated list of other sites whose pages can open WebSockets to the leader, eg: https://dashboard.example.com. The leader's own pages are always allowed, '*' allows any site.")
 
 	// Ports
 	cmd.Flags().IntVarP(&cfg.GRPCPort, "grpc-port", "g", 6886, "Which port the GRPC Server should bind to.")
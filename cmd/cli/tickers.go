@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func newTickersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tickers",
+		Short: `Manage the tickers of a currently running cluster.`,
+		Long:  `Manage the tickers of a currently running cluster.`,
+	}
+
+	cmd.AddCommand(newTickersAddCmd())
+	cmd.AddCommand(newTickersRemoveCmd())
+	cmd.AddCommand(newTickersListCmd())
+
+	return cmd
+}
+
+func newTickersAddCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "add [tickers...]",
+		Short: `Add tickers to the ticker wall.`,
+		Long:  `Add tickers to the ticker wall.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			for _, symbol := range args {
+				ticker, err := leaderClient.client.AddTicker(context.Background(), &models.TickerRequest{Ticker: symbol, Wall: wall})
+				if err != nil {
+					return err
+				}
+
+				logrus.Info("Added ", ticker.Ticker, " [ ", ticker.CompanyName, " ]")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newTickersRemoveCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "remove [tickers...]",
+		Short: `Remove tickers from the ticker wall.`,
+		Long:  `Remove tickers from the ticker wall.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			for _, symbol := range args {
+				ticker, err := leaderClient.client.RemoveTicker(context.Background(), &models.TickerRequest{Ticker: symbol, Wall: wall})
+				if err != nil {
+					return err
+				}
+
+				logrus.Info("Removed ", ticker.Ticker)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newTickersListCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: `List the tickers on the ticker wall.`,
+		Long:  `List the tickers on the ticker wall.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			tickers, err := leaderClient.client.ListTickers(context.Background(), &models.WallRequest{Wall: wall})
+			if err != nil {
+				return err
+			}
+
+			for _, t := range tickers.Tickers {
+				fmt.Printf(" - %-6s %10.2f  [ %s ]\n", t.Ticker, t.Price, t.CompanyName)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
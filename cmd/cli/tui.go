@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/polygon-io/go-app-ticker-wall/tui"
+	"github.com/spf13/cobra"
+)
+
+func newTUICmd() *cobra.Command {
+	cfg := &tui.Config{}
+
+	cmd := &cobra.Command{
+		Use:   "tui",
+		Short: `Watch a wall in the terminal.`,
+		Long: `Watch a wall in the terminal. This joins the cluster as an observer, so it is not part of the
+screen layout, and follows the tape as it's shown on the first screen of the wall.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg.ClientConfig.Leader, _ = cmd.Flags().GetString("leader")
+			cfg.ClientConfig.Wall, _ = cmd.Flags().GetString("wall")
+			cfg.ClientConfig.Auth = authConfig(cmd)
+
+			return tui.Run(cfg)
+		},
+	}
+
+	cmd.Flags().IntVarP(&cfg.FPS, "fps", "", 20, "How many times a second the terminal is redrawn.")
+
+	// Dont auto sort flags.
+	cmd.Flags().SortFlags = false
+
+	return cmd
+}
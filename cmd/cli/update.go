@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func newUpdateCmd() *cobra.Command {
@@ -18,11 +21,25 @@ func newUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update",
 		Short: `Update settings of a currently running server.`,
-		Long:  `Update settings of a currently running server.`,
+		Long: `Update settings of a currently running server.
+Only the settings given as flags are changed, everything else keeps its current value.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Only send the settings which were actually set.
+			updateMask := &fieldmaskpb.FieldMask{}
+			cmd.Flags().Visit(func(f *pflag.Flag) {
+				if path, ok := presentationFlagPaths[f.Name]; ok {
+					updateMask.Paths = append(updateMask.Paths, path)
+				}
+			})
+
+			if len(updateMask.Paths) == 0 {
+				return errors.New("no settings to update, see 'tickerwall update --help' for the available settings")
+			}
+
 			// Create a leader client...
 			leader, _ := cmd.Flags().GetString("leader")
-			leaderClient, err = NewServerClient(leader)
+			wall, _ := cmd.Flags().GetString("wall")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
 			if err != nil {
 				return err
 			}
@@ -31,7 +48,11 @@ func newUpdateCmd() *cobra.Command {
 
 			parseColorMap(colorMap, newSettings)
 
-			if _, err = leaderClient.client.UpdatePresentationSettings(context.Background(), newSettings); err != nil {
+			if _, err = leaderClient.client.UpdatePresentationSettings(context.Background(), &models.UpdatePresentationSettingsRequest{
+				PresentationSettings: newSettings,
+				UpdateMask:           updateMask,
+				Wall:                 wall,
+			}); err != nil {
 				return err
 			}
 
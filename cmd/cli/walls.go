@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func newWallsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "walls",
+		Short: `Manage the walls of a currently running cluster.`,
+		Long:  `Manage the walls of a currently running cluster.`,
+	}
+
+	cmd.AddCommand(newWallsCreateCmd())
+	cmd.AddCommand(newWallsDeleteCmd())
+	cmd.AddCommand(newWallsListCmd())
+
+	return cmd
+}
+
+func newWallsCreateCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "create [walls...]",
+		Short: `Create walls, which start as a copy of the default wall.`,
+		Long:  `Create walls, which start as a copy of the default wall's settings and tickers.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			for _, name := range args {
+				if _, err := leaderClient.client.CreateWall(context.Background(), &models.WallRequest{Wall: name}); err != nil {
+					return err
+				}
+
+				logrus.Info("Created ", models.WallName(name))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newWallsDeleteCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "delete [walls...]",
+		Short: `Delete walls, disconnecting their screens.`,
+		Long:  `Delete walls, disconnecting their screens. The default wall can't be deleted.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			for _, name := range args {
+				if _, err := leaderClient.client.DeleteWall(context.Background(), &models.WallRequest{Wall: name}); err != nil {
+					return err
+				}
+
+				logrus.Info("Deleted ", models.WallName(name))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func newWallsListCmd() *cobra.Command {
+	var leaderClient *ServerClient
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: `List the walls served by the leader.`,
+		Long:  `List the walls served by the leader.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Create a leader client...
+			leader, _ := cmd.Flags().GetString("leader")
+			leaderClient, err = NewServerClient(leader, authConfig(cmd))
+			if err != nil {
+				return err
+			}
+			logrus.Debug("Connected to Leader.")
+
+			walls, err := leaderClient.client.ListWalls(context.Background(), &models.Empty{})
+			if err != nil {
+				return err
+			}
+
+			for _, name := range walls.Walls {
+				fmt.Println(" -", name)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
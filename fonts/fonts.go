@@ -8,17 +8,21 @@ import (
 	_ "embed"
 
 	"github.com/polygon-io/nanovgo"
+	"github.com/polygon-io/nanovgo/fontstashmini"
 )
 
 // nolint:gochecknoglobals // not sure how else to go about this.
+//
 //go:embed Roboto-Regular.ttf
 var fontsRobotoRegular []byte
 
 // nolint:gochecknoglobals // not sure how else to go about this.
+//
 //go:embed Roboto-Light.ttf
 var fontsRobotoLight []byte
 
 // nolint:gochecknoglobals // not sure how else to go about this.
+//
 //go:embed Roboto-Bold.ttf
 var fontsRobotoBold []byte
 
@@ -28,3 +32,24 @@ func CreateFonts(ctx *nanovgo.Context) {
 	ctx.CreateFontFromMemory("sans-light", fontsRobotoLight, 1)
 	ctx.CreateFontFromMemory("sans-bold", fontsRobotoBold, 1)
 }
+
+// AddFonts attaches the fonts to a font stash, for measuring or rasterizing text without a nanovgo context.
+func AddFonts(stash *fontstashmini.FontStash) {
+	stash.AddFontFromMemory("sans", fontsRobotoRegular, 0)
+	stash.AddFontFromMemory("sans-light", fontsRobotoLight, 0)
+	stash.AddFontFromMemory("sans-bold", fontsRobotoBold, 0)
+}
+
+// TTF gets the TrueType font file of a font face, nil if there isn't a font face with that name.
+// This is used to serve the fonts to browsers, so they measure text the same way the leader does.
+func TTF(face string) []byte {
+	switch face {
+	case "sans":
+		return fontsRobotoRegular
+	case "sans-light":
+		return fontsRobotoLight
+	case "sans-bold":
+		return fontsRobotoBold
+	}
+	return nil
+}
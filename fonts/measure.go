@@ -0,0 +1,47 @@
+package fonts
+
+import (
+	"sync"
+
+	"github.com/polygon-io/nanovgo/fontstashmini"
+)
+
+// measureAtlasSize is the size of the glyph atlas used while measuring. Only a couple of font
+// sizes are measured, so this doesn't need to grow.
+const measureAtlasSize = 1024
+
+// Measurer measures text using the same fonts as the GUI, without needing a render context. This
+// allows the leader to size things the same way the screens will draw them.
+type Measurer struct {
+	sync.Mutex
+
+	stash *fontstashmini.FontStash
+}
+
+// NewMeasurer creates a new text measurer with all of our fonts loaded.
+func NewMeasurer() *Measurer {
+	stash := fontstashmini.New(measureAtlasSize, measureAtlasSize)
+	AddFonts(stash)
+
+	return &Measurer{
+		stash: stash,
+	}
+}
+
+// TextWidth measures how wide the text is, in pixels, when drawn using the given font face and size.
+func (m *Measurer) TextWidth(face string, size float32, text string) float32 {
+	m.Lock()
+	defer m.Unlock()
+
+	font := m.stash.GetFontByName(face)
+	if font == fontstashmini.INVALID {
+		return 0
+	}
+
+	m.stash.SetFont(font)
+	m.stash.SetSize(size)
+	m.stash.SetAlign(fontstashmini.ALIGN_LEFT | fontstashmini.ALIGN_BASELINE)
+
+	width, _ := m.stash.TextBounds(0, 0, text)
+	return width
+}
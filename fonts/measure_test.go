@@ -0,0 +1,30 @@
+package fonts
+
+import "testing"
+
+func TestTextWidth(t *testing.T) {
+	m := NewMeasurer()
+
+	tests := []struct {
+		name string
+		face string
+		size float32
+		text string
+		want float32
+	}{
+		{name: "ticker", face: "sans-bold", size: 96, text: "AAPL", want: 180},
+		{name: "price", face: "sans-bold", size: 96, text: "110.00", want: 231},
+		{name: "smaller font", face: "sans-light", size: 58, text: "AAPL", want: 104},
+		{name: "digits are the same width", face: "sans-bold", size: 96, text: "11", want: 84},
+		{name: "empty", face: "sans-bold", size: 96, text: "", want: 0},
+		{name: "unknown font", face: "comic-sans", size: 96, text: "AAPL", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.TextWidth(tt.face, tt.size, tt.text); got != tt.want {
+				t.Errorf("TextWidth(%q, %v, %q) = %v, want %v", tt.face, tt.size, tt.text, got, tt.want)
+			}
+		})
+	}
+}
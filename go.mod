@@ -9,7 +9,6 @@ require (
 	github.com/gorilla/websocket v1.5.0
 	github.com/goxjs/gl v0.0.0-20210104184919-e3fafc6f8f2a
 	github.com/goxjs/glfw v0.0.0-20191126052801-d2efb5f20838
-	github.com/imdario/mergo v0.3.12
 	github.com/polygon-io/client-go v0.10.0
 	github.com/polygon-io/nanovgo v0.0.0-20210406222537-1c1e04bebee3
 	github.com/sirupsen/logrus v1.8.1
@@ -17,6 +16,7 @@ require (
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.9.0
 	golang.org/x/net v0.0.0-20211112202133-69e39bad7dc2
+	golang.org/x/sys v0.0.0-20211019181941-9d821ace8654
 	google.golang.org/grpc v1.40.0
 	google.golang.org/protobuf v1.27.1
 	gopkg.in/tomb.v2 v2.0.0-20161208151619-d5d1b5820637
@@ -53,7 +53,6 @@ require (
 	github.com/ugorji/go/codec v1.1.7 // indirect
 	golang.org/x/crypto v0.0.0-20211215153901-e495a2d5b3d3 // indirect
 	golang.org/x/exp v0.0.0-20220414153411-bcd21879b8fd // indirect
-	golang.org/x/sys v0.0.0-20211019181941-9d821ace8654 // indirect
 	golang.org/x/text v0.3.7 // indirect
 	google.golang.org/genproto v0.0.0-20210828152312-66f60bf46e71 // indirect
 	gopkg.in/ini.v1 v1.63.2 // indirect
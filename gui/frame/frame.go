@@ -0,0 +1,34 @@
+// Package frame draws what a screen displays using a render.Renderer. It has no dependency on a
+// window or OpenGL context, so frames can be drawn in software as well as by the GUI.
+package frame
+
+import (
+	"image/color"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/polygon-io/go-app-ticker-wall/render"
+)
+
+// Background fills the screen with the background color.
+func Background(r render.Renderer, settings *models.PresentationSettings, width, height float32) {
+	r.FillRect(0, 0, width, height, 0, settings.BGColor.ToNRGBA())
+}
+
+// SystemPanel draws a system message across the middle of the screen, such as the connection
+// to the leader being lost.
+func SystemPanel(r render.Renderer, message string, width, height float32) {
+	systemDialogPanelHeight := float32(200)
+	systemDialogPadding := float32(20)
+
+	fromTop := (height / 2) - (systemDialogPanelHeight / 2)
+
+	// Set BG color.
+	r.FillRect(systemDialogPadding, fromTop, width-(systemDialogPadding*2), systemDialogPanelHeight, 5, color.NRGBA{R: 255, A: 222})
+
+	r.Text(width/2, height/2, render.TextStyle{
+		Face:  "sans-bold",
+		Size:  32,
+		Align: render.AlignCenter | render.AlignMiddle,
+		Color: color.NRGBA{R: 255, G: 255, B: 255, A: 255},
+	}, message)
+}
@@ -0,0 +1,210 @@
+package frame
+
+import (
+	"flag"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/gui/notifications"
+	"github.com/polygon-io/go-app-ticker-wall/layout"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/polygon-io/go-app-ticker-wall/render"
+)
+
+// Run `go test ./gui/frame -update` to regenerate the golden images after an intended change.
+var update = flag.Bool("update", false, "update the golden images")
+
+const (
+	screenWidth  = 1600
+	screenHeight = 400
+
+	// goldenChannelTolerance is how far a color channel can be from the golden image before the pixel
+	// counts as different, and goldenPixelTolerance is the fraction of pixels which can be different.
+	// This allows for small floating point differences between platforms.
+	goldenChannelTolerance = 8
+	goldenPixelTolerance   = 0.001
+)
+
+// testNow is the time all of the frames are drawn at.
+var testNow = time.UnixMilli(1650000000000)
+
+func testSettings() *models.PresentationSettings {
+	return &models.PresentationSettings{
+		TickerBoxWidth:      1100,
+		AnimationDurationMS: 500,
+		UpColor:             &models.RGBA{Red: 51, Green: 255, Blue: 51, Alpha: 255},
+		DownColor:           &models.RGBA{Red: 255, Green: 51, Blue: 51, Alpha: 255},
+		FontColor:           &models.RGBA{Red: 255, Green: 255, Blue: 255, Alpha: 255},
+		TickerBoxBGColor:    &models.RGBA{Red: 20, Green: 20, Blue: 20, Alpha: 255},
+		BGColor:             &models.RGBA{Red: 1, Green: 1, Blue: 1, Alpha: 255},
+		ScrollEpoch: &models.ScrollEpoch{
+			TimestampMS: testNow.UnixMilli(),
+			Offset:      300,
+		},
+	}
+}
+
+func testTickers() []*models.Ticker {
+	tickers := []*models.Ticker{
+		{Ticker: "AAPL", CompanyName: "Apple Inc.", Price: 165.29, PreviousClosePrice: 160.24},
+		{Ticker: "BRK.A", CompanyName: "Berkshire Hathaway Inc.", Price: 525000, PreviousClosePrice: 531000},
+		{Ticker: "F", CompanyName: "Ford Motor Co", Price: 16.5, PreviousClosePrice: 16.5},
+	}
+
+	for i, ticker := range tickers {
+		ticker.Index = int32(i)
+		ticker.PriceChangePercentage = ((ticker.Price / ticker.PreviousClosePrice) - 1) * 100
+
+		// Walk the price from the previous close to the current price.
+		for j := 0; j <= 10; j++ {
+			wobble := float64((j*7)%5-2) * ticker.PreviousClosePrice * 0.002
+			ticker.Aggs = append(ticker.Aggs, &models.Agg{
+				Price: ticker.PreviousClosePrice + (ticker.Price-ticker.PreviousClosePrice)*float64(j)/10 + wobble,
+			})
+		}
+	}
+
+	return tickers
+}
+
+func testCluster(settings *models.PresentationSettings) *models.ScreenCluster {
+	return &models.ScreenCluster{
+		Settings: settings,
+		Screens: []*models.Screen{
+			{UUID: "screen", Width: screenWidth, Height: screenHeight},
+		},
+	}
+}
+
+// drawTape draws the background and tape, as the GUI does.
+func drawTape(r render.Renderer, settings *models.PresentationSettings, tickers []*models.Ticker) {
+	boxes := layout.ScreenBoxes(settings, testCluster(settings), tickers, "screen", screenWidth, testNow)
+
+	Background(r, settings, screenWidth, screenHeight)
+	Tape(r, settings, boxes, screenHeight)
+}
+
+func TestTapeFixedWidths(t *testing.T) {
+	r := render.NewSoftware(screenWidth, screenHeight)
+	drawTape(r, testSettings(), testTickers())
+
+	checkGolden(t, "tape-fixed-widths", r.Image())
+}
+
+func TestTapeDynamicWidthsWithSpacer(t *testing.T) {
+	r := render.NewSoftware(screenWidth, screenHeight)
+
+	settings := testSettings()
+	settings.TickerBoxWidth = 600
+	settings.DynamicTickerWidths = true
+	settings.SpacerWidth = 700
+	settings.SpacerText = "Polygon.io"
+	settings.ScrollEpoch.Offset = 2400
+
+	// Lay the boxes out the same way the leader does.
+	tickers := testTickers()
+	settings.TickerLayout = &models.TickerLayout{}
+	for _, ticker := range tickers {
+		width := layout.BoxWidth(r, ticker, settings.TickerBoxWidth)
+		settings.TickerLayout.Boxes = append(settings.TickerLayout.Boxes, &models.TickerBox{
+			Ticker: ticker.Ticker,
+			Offset: settings.TickerLayout.TapeWidth,
+			Width:  width,
+		})
+		settings.TickerLayout.TapeWidth += width
+	}
+
+	drawTape(r, settings, tickers)
+
+	checkGolden(t, "tape-dynamic-widths-spacer", r.Image())
+}
+
+func TestSystemPanel(t *testing.T) {
+	r := render.NewSoftware(screenWidth, screenHeight)
+	drawTape(r, testSettings(), testTickers())
+	SystemPanel(r, "Reconnecting to Leader..", screenWidth, screenHeight)
+
+	checkGolden(t, "system-panel", r.Image())
+}
+
+func TestAnnouncement(t *testing.T) {
+	r := render.NewSoftware(screenWidth, screenHeight)
+	settings := testSettings()
+	drawTape(r, settings, testTickers())
+
+	mgr := notifications.NewManager(func() time.Time { return testNow })
+	mgr.UpdateAttributes(settings, testCluster(settings), &models.Screen{UUID: "screen", Width: screenWidth, Height: screenHeight})
+	mgr.AddNotification(&models.Announcement{
+		Message:           "Markets close early today",
+		AnnouncementType:  int32(models.AnnouncementTypeSuccess),
+		ShowAtTimestampMS: testNow.UnixMilli() - 1000,
+		LifespanMS:        5000,
+	})
+	mgr.RenderLoop(r)
+
+	checkGolden(t, "announcement", r.Image())
+}
+
+// checkGolden compares the image against the golden image in testdata, or updates the golden
+// image when running with -update.
+func checkGolden(t *testing.T, name string, img *image.RGBA) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".png")
+
+	if *update {
+		f, err := os.Create(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+
+		if err := png.Encode(f, img); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unable to open golden image, run with -update to create it: %v", err)
+	}
+	defer f.Close()
+
+	golden, err := png.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if golden.Bounds() != img.Bounds() {
+		t.Fatalf("image is %v, golden image is %v", img.Bounds(), golden.Bounds())
+	}
+
+	different := 0
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r1, g1, b1, a1 := img.At(x, y).RGBA()
+			r2, g2, b2, a2 := golden.At(x, y).RGBA()
+			if channelDiff(r1, r2) > goldenChannelTolerance || channelDiff(g1, g2) > goldenChannelTolerance ||
+				channelDiff(b1, b2) > goldenChannelTolerance || channelDiff(a1, a2) > goldenChannelTolerance {
+				different++
+			}
+		}
+	}
+
+	if fraction := float64(different) / float64(bounds.Dx()*bounds.Dy()); fraction > goldenPixelTolerance {
+		t.Errorf("%d pixels ( %.2f%% ) are different from %s", different, fraction*100, path)
+	}
+}
+
+// channelDiff is the difference between two 16 bit color channels, in 8 bit terms.
+func channelDiff(a, b uint32) uint32 {
+	if a > b {
+		return (a - b) >> 8
+	}
+	return (b - a) >> 8
+}
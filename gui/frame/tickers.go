@@ -0,0 +1,167 @@
+package frame
+
+import (
+	"math"
+
+	"github.com/polygon-io/go-app-ticker-wall/layout"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/polygon-io/go-app-ticker-wall/render"
+)
+
+// graphViewportPercentage is the amount of movement ( up or down ) we chart at
+// native scale before we are required to "squish" to fit into the viewport.
+const graphViewportPercentage = .04 // 4% viewport movement range up or down ( 8% total ).
+
+// Tape draws the ticker boxes and spacers on the tape, vertically centered on the screen.
+func Tape(r render.Renderer, settings *models.PresentationSettings, boxes []layout.Box, screenHeight float32) {
+	for _, box := range boxes {
+		if box.IsSpacer() {
+			spacer(r, settings, float32(box.Offset), screenHeight)
+			continue
+		}
+		ticker(r, settings, box.Ticker, float32(box.Offset), float32(box.Width), screenHeight)
+	}
+}
+
+// tickerBg sets the background of the ticker box to a solid color.
+func tickerBg(r render.Renderer, settings *models.PresentationSettings, leftOffset, tickerBoxWidth, screenHeight float32) {
+	topOffset := (screenHeight / 2) - (layout.TickerBoxHeight / 2)
+	leftOffset += (layout.TickerBoxMargin / 2)
+	boxWidth := tickerBoxWidth - layout.TickerBoxMargin
+
+	r.FillRect(leftOffset, topOffset, boxWidth, layout.TickerBoxHeight, layout.TickerBoxBorderRadius, settings.TickerBoxBGColor.ToNRGBA())
+}
+
+func ticker(r render.Renderer, settings *models.PresentationSettings, ticker *models.Ticker, tickerOffset, tickerBoxWidth, screenHeight float32) {
+	// Render background rectangle.
+	tickerBg(r, settings, tickerOffset, tickerBoxWidth, screenHeight)
+
+	// Calculate offsets.
+	offsetLeft := (tickerOffset + (layout.TickerBoxMargin / 2)) + layout.TickerBoxPadding
+	offsetTop := (screenHeight / 2) - (layout.TickerBoxHeight / 2)
+	offsetRight := ((tickerOffset + tickerBoxWidth) - layout.TickerBoxMargin) - layout.TickerBoxPadding
+
+	// Calculate the Y offset for the two rows. Using percentages so if we change
+	// ticker box size, it should scale accordingly.
+	upperRowTopOffset := offsetTop + (layout.TickerBoxHeight * .33)
+	lowerRowTopOffset := offsetTop + (layout.TickerBoxHeight * .66)
+
+	// Dynamic width boxes put the graph right after the ticker and company name.
+	graphLeft := offsetLeft + layout.GraphOffset
+	if settings.DynamicTickerWidths {
+		graphLeft = offsetLeft + layout.LeftColumnWidth(r, ticker) + layout.GraphSpacing
+	}
+
+	// Actual text rendering ---
+	upperRow := render.TextStyle{
+		Face:  layout.UpperRowFontFace,
+		Size:  layout.UpperRowFontSize,
+		Align: render.AlignLeft | render.AlignMiddle,
+		Color: settings.FontColor.ToNRGBA(),
+	}
+	lowerRow := upperRow
+	lowerRow.Face = layout.BottomRowFontFace
+	lowerRow.Size = layout.BottomRowFontSize
+
+	// Ticker.
+	r.Text(offsetLeft, upperRowTopOffset, upperRow, ticker.Ticker)
+
+	// Price.
+	textString := layout.PriceText(ticker)
+	r.Text(offsetRight-r.TextWidth(upperRow.Face, upperRow.Size, textString), upperRowTopOffset, upperRow, textString)
+
+	// Company Name.
+	r.Text(offsetLeft, lowerRowTopOffset, lowerRow, layout.CompanyNameText(ticker))
+
+	// Percentage Gained / Loss test.
+	directionalColor := settings.UpColor
+	if ticker.PriceChangePercentage < 0 {
+		directionalColor = settings.DownColor
+	}
+	lowerRow.Color = directionalColor.ToNRGBA()
+	textString = layout.ChangeText(ticker)
+	r.Text(offsetRight-r.TextWidth(lowerRow.Face, lowerRow.Size, textString), lowerRowTopOffset, lowerRow, textString)
+
+	// Graph.
+	topOffset := (screenHeight / 2) - (layout.GraphSize / 2)
+	graph(r, ticker, graphLeft, topOffset, layout.GraphSize, layout.GraphSize, directionalColor)
+}
+
+// spacer draws the spacer card which sits between repetitions of the tape.
+func spacer(r render.Renderer, settings *models.PresentationSettings, spacerOffset, screenHeight float32) {
+	// Without any text the spacer is just a gap.
+	if settings.SpacerText == "" {
+		return
+	}
+
+	tickerBg(r, settings, spacerOffset, float32(settings.SpacerWidth), screenHeight)
+
+	r.Text(spacerOffset+(float32(settings.SpacerWidth)/2), screenHeight/2, render.TextStyle{
+		Face:  layout.UpperRowFontFace,
+		Size:  layout.UpperRowFontSize,
+		Align: render.AlignCenter | render.AlignMiddle,
+		Color: settings.FontColor.ToNRGBA(),
+	}, settings.SpacerText)
+}
+
+func graph(r render.Renderer, ticker *models.Ticker, x, y, w, h float32, color *models.RGBA) {
+	points := len(ticker.Aggs)
+
+	// if we have no data, don't continue.
+	if points < 2 {
+		return
+	}
+
+	path := make([]render.Point, points)
+	dx := w / float32(points-1)
+
+	// Generate graph points.
+	var min, max float64
+	for i, agg := range ticker.Aggs {
+		// Check if max.
+		if agg.Price > max {
+			max = agg.Price
+		}
+
+		// Check if min.
+		if agg.Price < min || min == 0 {
+			min = agg.Price
+		}
+
+		// Set X,Y for this point.
+		path[i] = render.Point{X: x + float32(i)*dx, Y: float32(agg.Price)}
+	}
+
+	// Middle of our range.
+	midRange := float32((min + max) / 2)
+
+	// Now we must normalize Y axis to fix in our bounds.
+	var absMax float32
+	for i := range path {
+		path[i].Y = (path[i].Y - midRange) / midRange
+		absValue := float32(math.Abs(float64(path[i].Y)))
+		if absValue > absMax {
+			absMax = absValue
+		}
+	}
+
+	// If our values are outside of the viewport range percentage, we must squish values
+	// to be inside our desired viewport range percentage.
+	if absMax > graphViewportPercentage {
+		for i := range path {
+			path[i].Y = (path[i].Y / absMax) * graphViewportPercentage
+		}
+	}
+
+	// Change percentage diff to pixel offsets:
+	middleOfViewport := h / 2
+	baseMultiplier := (middleOfViewport / graphViewportPercentage)
+	for i := range path {
+		path[i].Y = (y + h) - ((baseMultiplier * path[i].Y) + middleOfViewport)
+	}
+
+	r.StrokePath(path, 4.0, color.ToNRGBA())
+
+	last := path[points-1]
+	r.FillCircle(last.X, last.Y, 6.0, color.ToNRGBA())
+}
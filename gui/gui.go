@@ -3,14 +3,15 @@ package gui
 import (
 	"context"
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/goxjs/gl"
 	"github.com/goxjs/glfw"
 	"github.com/polygon-io/go-app-ticker-wall/client"
 	"github.com/polygon-io/go-app-ticker-wall/fonts"
+	"github.com/polygon-io/go-app-ticker-wall/gui/frame"
 	"github.com/polygon-io/go-app-ticker-wall/gui/notifications"
+	"github.com/polygon-io/go-app-ticker-wall/render"
 	"github.com/polygon-io/nanovgo"
 	"github.com/polygon-io/nanovgo/perfgraph"
 	"github.com/sirupsen/logrus"
@@ -22,6 +23,7 @@ type GUI struct {
 	// nanov
 	window   *glfw.Window
 	nanoCtx  *nanovgo.Context
+	renderer *render.NanoVG
 	fpsGraph *perfgraph.PerfGraph
 
 	//
@@ -40,7 +42,7 @@ func NewGUI(clientObj client.Client) *GUI {
 		client: clientObj,
 		logos:  NewLogosManager(),
 		// Create notifications manager.
-		notifications: notifications.NewManager(),
+		notifications: notifications.NewManager(clientObj.Now),
 	}
 
 	return obj
@@ -76,6 +78,7 @@ func (g *GUI) Setup() error {
 		return err
 	}
 	g.nanoCtx = nanoCtx
+	g.renderer = render.NewNanoVG(nanoCtx)
 
 	// This limits the refresh rate to that of the display.
 	glfw.SwapInterval(1)
@@ -103,8 +106,8 @@ func (g *GUI) Setup() error {
 
 	go g.listenForAnnouncements()
 
-	// Set the logo managers context.
-	return g.logos.Setup(g.nanoCtx)
+	// Set the logo managers renderer.
+	return g.logos.Setup(g.renderer)
 }
 
 // Close shuts down the GUI.
@@ -177,19 +180,17 @@ func (g *GUI) renderFrame() error {
 
 	g.fpsGraph.UpdateGraph()
 
-	globalOffsetTimestamp := g.generateGlobalOffset()
-
 	// Set BG color
 	g.paintBG()
 
 	// Tickers.
-	if err := g.renderTickers(globalOffsetTimestamp); err != nil {
+	if err := g.renderTickers(); err != nil {
 		return err
 	}
 
 	// Notifications.
 	g.notifications.UpdateAttributes(settings, cluster, screen)
-	g.notifications.RenderLoop(g.nanoCtx)
+	g.notifications.RenderLoop(g.renderer)
 
 	g.renderFPSGraph()
 	return nil
@@ -215,33 +216,9 @@ func (g *GUI) renderFPSGraph() {
 	}
 }
 
-// generateGlobalOffset generates the pixel offset taking into account the scroll speed.
-func (g *GUI) generateGlobalOffset() float32 {
-	settings := g.client.GetSettings()
-	tickers := g.client.GetTickers()
-
-	newGlobalOffset := float64(time.Now().UnixNano()) / float64(int(settings.ScrollSpeed)*int(time.Millisecond))
-
-	tickerBoxWidth := float32(settings.TickerBoxWidth)
-	tapeWidth := float32(len(tickers)) * tickerBoxWidth
-	baseDivisible := math.Floor(newGlobalOffset / float64(tapeWidth))
-	newGlobalOffset -= baseDivisible * float64(tapeWidth)
-
-	return float32(newGlobalOffset)
-}
-
 // paintBG sets the background of the window to a solid color.
 func (g *GUI) paintBG() {
 	settings := g.client.GetSettings()
 
-	// Set BG color
-	g.nanoCtx.BeginPath()
-	g.nanoCtx.RoundedRect(0, 0, float32(g.windowWidth), float32(g.windowHeight), 0)
-	g.nanoCtx.SetFillColor(nanovgo.RGBA(
-		uint8(settings.BGColor.Red),
-		uint8(settings.BGColor.Green),
-		uint8(settings.BGColor.Blue),
-		uint8(settings.BGColor.Alpha),
-	))
-	g.nanoCtx.Fill()
+	frame.Background(g.renderer, settings, float32(g.windowWidth), float32(g.windowHeight))
 }
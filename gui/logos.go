@@ -8,15 +8,15 @@ import (
 	"time"
 
 	"github.com/polygon-io/go-app-ticker-wall/models"
-	"github.com/polygon-io/nanovgo"
+	"github.com/polygon-io/go-app-ticker-wall/render"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
 type LogoManager struct {
 	sync.RWMutex
-	logoMap map[string]*Logo
-	nanoCtx *nanovgo.Context
+	logoMap  map[string]*Logo
+	renderer render.Renderer
 	// NeedsRenderAccess is a flag we set when we need access to the main render thread.
 	// We cannot load images into context unless it's on the main render thread.
 	NeedsRenderAccess bool
@@ -29,8 +29,8 @@ func NewLogosManager() *LogoManager {
 }
 
 type Logo struct {
-	Status     logoStatus
-	NanovImgID int
+	Status logoStatus
+	Image  render.Image
 	// tempImgData holds the images data until we can load it into render context.
 	tempImgData []byte
 }
@@ -96,7 +96,7 @@ func (l *LogoManager) DownloadLogo(ticker *models.Ticker) error {
 	// Signal we are ready to load.
 	l.NeedsRenderAccess = true
 
-	// tickerLogo.NanovImgID = l.nanoCtx.CreateImageFromMemory(0, imgBuff)
+	// tickerLogo.Image, err = l.renderer.CreateImage(imgBuff)
 
 	logrus.Debug("Done downloading logo for: ", ticker.Ticker)
 	return nil
@@ -119,8 +119,14 @@ func (l *LogoManager) RenderThread() {
 
 	for _, tickerLogo := range l.logoMap {
 		if tickerLogo.Status == logoStatusReadyToLoad {
-			tickerLogo.NanovImgID = l.nanoCtx.CreateImageFromMemory(0, tickerLogo.tempImgData)
+			img, err := l.renderer.CreateImage(tickerLogo.tempImgData)
 			tickerLogo.tempImgData = nil
+			if err != nil {
+				logrus.WithError(err).Warn("Unable to load ticker logo.")
+				tickerLogo.Status = logoStatusError
+				continue
+			}
+			tickerLogo.Image = img
 			tickerLogo.Status = logoStatusOK
 		}
 	}
@@ -167,10 +173,10 @@ func (l *LogoManager) GetTickerImage(ticker *models.Ticker) *Logo {
 	return nil
 }
 
-func (l *LogoManager) Setup(nanoCtx *nanovgo.Context) error {
+func (l *LogoManager) Setup(renderer render.Renderer) error {
 	// Load in some default images to context.
 	logrus.Debug("Setup the logo manager completed.")
-	l.nanoCtx = nanoCtx
+	l.renderer = renderer
 	return nil
 }
 
@@ -183,9 +189,5 @@ func (g *GUI) renderTickerLogo(offset, logoSize float32, ticker *models.Ticker)
 	}
 
 	// Paint the logo
-	imgPaint := nanovgo.ImagePattern(offset, 182.5, logoSize, logoSize, 0.0/180.0*nanovgo.PI, tickerImg.NanovImgID, 1)
-	g.nanoCtx.BeginPath()
-	g.nanoCtx.RoundedRect(offset, 182.5, logoSize, logoSize, 5)
-	g.nanoCtx.SetFillPaint(imgPaint)
-	g.nanoCtx.Fill()
+	g.renderer.DrawImage(tickerImg.Image, offset, 182.5, logoSize, logoSize, 5)
 }
@@ -1,14 +1,19 @@
 package notifications
 
 import (
+	"time"
+
 	"github.com/polygon-io/go-app-ticker-wall/models"
-	"github.com/polygon-io/nanovgo"
+	"github.com/polygon-io/go-app-ticker-wall/render"
 	"github.com/sirupsen/logrus"
 )
 
 type Manager struct {
 	Notifications []*Notification
 
+	// now is the clock notifications are timed with, this should be in sync with the leader.
+	now func() time.Time
+
 	// These attributes are collected on initialization. If any changes to these attributes happen
 	// after creation, they will not be updated.
 	settings *models.PresentationSettings
@@ -16,8 +21,10 @@ type Manager struct {
 	cluster  *models.ScreenCluster
 }
 
-func NewManager() *Manager {
-	mgr := &Manager{}
+func NewManager(now func() time.Time) *Manager {
+	mgr := &Manager{
+		now: now,
+	}
 	return mgr
 }
 
@@ -31,7 +38,7 @@ func (m *Manager) UpdateAttributes(settings *models.PresentationSettings, cluste
 
 // RenderLoop loops through our current notifications to see if there are any which we should
 // call rendering methods on.
-func (m *Manager) RenderLoop(ctx *nanovgo.Context) {
+func (m *Manager) RenderLoop(r render.Renderer) {
 	validCount := 0
 	didGC := false
 	for _, notification := range m.Notifications {
@@ -46,7 +53,7 @@ func (m *Manager) RenderLoop(ctx *nanovgo.Context) {
 		validCount++
 
 		if notification.ShouldRender() {
-			notification.Render(ctx)
+			notification.Render(r)
 		}
 
 	}
@@ -1,11 +1,11 @@
 package notifications
 
 import (
-	"time"
+	"image/color"
 
 	ease "github.com/fogleman/ease"
 	"github.com/polygon-io/go-app-ticker-wall/models"
-	"github.com/polygon-io/nanovgo"
+	"github.com/polygon-io/go-app-ticker-wall/render"
 )
 
 type Notification struct {
@@ -27,7 +27,7 @@ func (n *Notification) setup() {
 // then we set it to completed, so we can garbage collect it.
 func (n *Notification) ShouldRender() bool {
 	// Current timestamp ( MS )
-	t := time.Now().UnixMilli()
+	t := n.mgr.now().UnixMilli()
 
 	// We are outside of this messages lifespan, disregard.
 	if t < n.announcement.ShowAtTimestampMS {
@@ -62,25 +62,29 @@ func (n *Notification) determineAnimations() {
 
 // Render actually renders the notification to the GUI context. `ShouldRender` should be run before this
 // to ensure the rendering method should be called on this notification.
-func (n *Notification) Render(ctx *nanovgo.Context) {
+func (n *Notification) Render(r render.Renderer) {
 	// Current timestamp ( MS )
-	t := time.Now().UnixMilli()
+	t := n.mgr.now().UnixMilli()
 
 	// Get necessary parameters.
 	settings := n.mgr.settings
 	screen := n.mgr.screen
 	cluster := n.mgr.cluster
 
+	// Announcements span the entire wall, so everything is positioned on the wall's canvas.
+	canvasWidth, canvasHeight := cluster.CanvasSize()
+	screenX, screenY := cluster.ScreenPosition(screen.UUID)
+
 	// Text Settings.
-	textTopStart := float64(-300)
-	textTopEnd := float64(140)
+	textTopStart := -float64(canvasHeight)
+	textTopEnd := (float64(canvasHeight) / 2) - 10
 	textTop := textTopEnd
 
 	// BG Settings.
 	bgBottomStart := float64(0)
-	bgBottomEnd := float64(screen.Height)
+	bgBottomEnd := float64(canvasHeight)
 	bgBottom := bgBottomEnd
-	bgTop := (bgBottom - float64(screen.Height))
+	bgTop := (bgBottom - float64(canvasHeight))
 
 	// Determine which animation to use for the announcement.
 	// To see more: https://github.com/fogleman/ease
@@ -93,7 +97,7 @@ func (n *Notification) Render(ctx *nanovgo.Context) {
 		// bg calcs
 		inPercCompleted := n.transformationAnimationIn(percentageCompleted)
 		bgBottom = bgBottomStart - ((bgBottomStart - bgBottomEnd) * inPercCompleted)
-		bgTop = (bgBottom - float64(screen.Height))
+		bgTop = (bgBottom - float64(canvasHeight))
 
 		// text calcs
 		textTop = textTopStart - ((textTopStart - textTopEnd) * inPercCompleted)
@@ -106,39 +110,34 @@ func (n *Notification) Render(ctx *nanovgo.Context) {
 		// bg calcs
 		outPercCompleted := n.transformationAnimationOut(percentageCompleted)
 		bgBottom = bgBottomEnd - ((bgBottomEnd - bgBottomStart) * outPercCompleted)
-		bgTop = (bgBottom - float64(screen.Height))
+		bgTop = (bgBottom - float64(canvasHeight))
 
 		// text calcs
 		textTop = textTopEnd - ((textTopEnd - textTopStart) * outPercCompleted)
 	}
 
-	ctx.Save()
-	defer ctx.Restore()
+	r.Save()
+	defer r.Restore()
 
-	ctx.BeginPath()
-	// Determine where the box should start ( may not be on our screen ).
-	screenGlobalOffset := cluster.ScreenGlobalOffset(screen.UUID)
-	left := -float32(screenGlobalOffset)
-	// Position bg.
-	ctx.RoundedRect(left, float32(bgTop), float32(cluster.GlobalViewportSize()), float32(bgBottom), 0)
+	// Move to where our screen is on the canvas ( the box may not start on our screen ).
+	r.Translate(-screenX, -screenY)
 
 	// Determine background color based on announcement type:].
+	bgColor := color.NRGBA{R: 122, G: 122, B: 255, A: 222}
 	if n.announcement.AnnouncementType == int32(models.AnnouncementTypeDanger) {
-		ctx.SetFillColor(nanovgo.RGBA(255, 122, 122, 222))
+		bgColor = color.NRGBA{R: 255, G: 122, B: 122, A: 222}
 	} else if n.announcement.AnnouncementType == int32(models.AnnouncementTypeSuccess) {
-		ctx.SetFillColor(nanovgo.RGBA(122, 255, 122, 222))
-	} else {
-		ctx.SetFillColor(nanovgo.RGBA(122, 122, 255, 222))
+		bgColor = color.NRGBA{R: 122, G: 255, B: 122, A: 222}
 	}
 
-	ctx.Fill()
-
-	ctx.SetFontSize(96.0)
-	ctx.SetFontFace("sans-bold")
-	ctx.SetTextAlign(nanovgo.AlignCenter | nanovgo.AlignMiddle)
-
-	// ctx.SetFontBlur(0)
-	ctx.SetFillColor(nanovgo.RGBA(255, 255, 255, 255))
-	middle := (float32(cluster.GlobalViewportSize()) / 2) - float32(screenGlobalOffset)
-	ctx.Text(middle, float32(textTop), n.announcement.Message)
+	// Position bg.
+	r.FillRect(0, float32(bgTop), float32(canvasWidth), float32(bgBottom), 0, bgColor)
+
+	middle := float32(canvasWidth) / 2
+	r.Text(middle, float32(textTop), render.TextStyle{
+		Face:  "sans-bold",
+		Size:  96.0,
+		Align: render.AlignCenter | render.AlignMiddle,
+		Color: color.NRGBA{R: 255, G: 255, B: 255, A: 255},
+	}, n.announcement.Message)
 }
@@ -0,0 +1,75 @@
+package layout
+
+import (
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// Box is something drawn on the tape, positioned on a screen.
+type Box struct {
+	// Ticker drawn in the box, nil when this is the spacer card.
+	Ticker *models.Ticker
+
+	// Offset is the left edge of the box, relative to the left edge of the screen.
+	Offset float64
+	Width  float64
+}
+
+// IsSpacer checks if this is the spacer card between repetitions of the tape.
+func (b Box) IsSpacer() bool {
+	return b.Ticker == nil
+}
+
+// TapeOffset gets how far the tape has scrolled at the given time, wrapped to one repetition of the tape.
+func TapeOffset(settings *models.PresentationSettings, tickerCount int, now time.Time) float64 {
+	return models.WrapOffset(settings.ScrollOffset(now), settings.TapeWidth(tickerCount))
+}
+
+// ScreenBoxes gets the boxes which are visible on a screen at the given time, and where they are
+// drawn. Tickers are positioned using the settings ticker layout, and the tape repeats itself as
+// many times as it takes to fill the screen. Tickers which aren't in the layout yet are skipped.
+func ScreenBoxes(settings *models.PresentationSettings, cluster *models.ScreenCluster, tickers []*models.Ticker, screenUUID string, screenWidth float64, now time.Time) []Box {
+	tapeWidth := settings.TapeWidth(len(tickers))
+
+	// Where the left edge of this screen is on the tape.
+	screenOffset := TapeOffset(settings, len(tickers), now) + float64(cluster.ScreenGlobalOffset(screenUUID))
+
+	var boxes []Box
+	for _, ticker := range tickers {
+		tickerOffset, tickerWidth, ok := settings.TickerBox(ticker)
+		if !ok {
+			continue
+		}
+
+		for _, offset := range TileOffsets(tickerOffset-screenOffset, tickerWidth, tapeWidth, screenWidth) {
+			boxes = append(boxes, Box{
+				Ticker: ticker,
+				Offset: offset,
+				Width:  tickerWidth,
+			})
+		}
+	}
+
+	if settings.SpacerWidth > 0 {
+		spacerWidth := float64(settings.SpacerWidth)
+		for _, offset := range TileOffsets(settings.TickersWidth(len(tickers))-screenOffset, spacerWidth, tapeWidth, screenWidth) {
+			boxes = append(boxes, Box{
+				Offset: offset,
+				Width:  spacerWidth,
+			})
+		}
+	}
+
+	return boxes
+}
+
+// WallBoxes gets the boxes which are visible on each screen of the cluster at the given time, by
+// screen UUID.
+func WallBoxes(settings *models.PresentationSettings, cluster *models.ScreenCluster, tickers []*models.Ticker, now time.Time) map[string][]Box {
+	res := make(map[string][]Box, len(cluster.Screens))
+	for _, screen := range cluster.Screens {
+		res[screen.UUID] = ScreenBoxes(settings, cluster, tickers, screen.UUID, float64(screen.Width), now)
+	}
+	return res
+}
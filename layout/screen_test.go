@@ -0,0 +1,351 @@
+package layout
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// testNow is the time all of the tests are laid out at.
+var testNow = time.UnixMilli(1650000000000)
+
+// stoppedSettings creates settings where the tape is stopped at the given offset.
+func stoppedSettings(offset float64, boxWidths []int32, spacerWidth int32) *models.PresentationSettings {
+	settings := &models.PresentationSettings{
+		TickerBoxWidth: 100,
+		SpacerWidth:    spacerWidth,
+		ScrollEpoch: &models.ScrollEpoch{
+			TimestampMS: testNow.UnixMilli(),
+			Offset:      offset,
+		},
+	}
+
+	if boxWidths != nil {
+		settings.TickerLayout = &models.TickerLayout{}
+		for i, width := range boxWidths {
+			settings.TickerLayout.Boxes = append(settings.TickerLayout.Boxes, &models.TickerBox{
+				Ticker: tickerSymbol(i),
+				Offset: settings.TickerLayout.TapeWidth,
+				Width:  width,
+			})
+			settings.TickerLayout.TapeWidth += width
+		}
+	}
+
+	return settings
+}
+
+func tickerSymbol(i int) string {
+	return fmt.Sprintf("T%02d", i)
+}
+
+func testTickers(count int) []*models.Ticker {
+	tickers := make([]*models.Ticker, count)
+	for i := range tickers {
+		tickers[i] = &models.Ticker{Ticker: tickerSymbol(i), Index: int32(i)}
+	}
+	return tickers
+}
+
+// boxSummary is a box, with the ticker replaced by it's symbol ( or "spacer" ), for comparing.
+type boxSummary struct {
+	Ticker string
+	Offset float64
+	Width  float64
+}
+
+func summarizeBoxes(boxes []Box) []boxSummary {
+	res := make([]boxSummary, len(boxes))
+	for i, box := range boxes {
+		res[i] = boxSummary{Ticker: "spacer", Offset: box.Offset, Width: box.Width}
+		if !box.IsSpacer() {
+			res[i].Ticker = box.Ticker.Ticker
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Offset < res[j].Offset })
+	return res
+}
+
+func TestScreenBoxes(t *testing.T) {
+	twoScreens := &models.ScreenCluster{
+		Screens: []*models.Screen{
+			{UUID: "a", Width: 250, Index: 0},
+			{UUID: "b", Width: 250, Index: 1, BezelLeft: 10},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		settings *models.PresentationSettings
+		tickers  int
+		screen   string
+		want     []boxSummary
+	}{
+		{
+			name:     "fixed widths at the start of the tape",
+			settings: stoppedSettings(0, nil, 0),
+			tickers:  4,
+			screen:   "a",
+			want:     []boxSummary{{"T00", 0, 100}, {"T01", 100, 100}, {"T02", 200, 100}},
+		},
+		{
+			name:     "fixed widths part way through the tape",
+			settings: stoppedSettings(150, nil, 0),
+			tickers:  4,
+			screen:   "a",
+			want:     []boxSummary{{"T01", -50, 100}, {"T02", 50, 100}, {"T03", 150, 100}},
+		},
+		{
+			name:     "wrapping around the end of the tape",
+			settings: stoppedSettings(350, nil, 0),
+			tickers:  4,
+			screen:   "a",
+			want:     []boxSummary{{"T03", -50, 100}, {"T00", 50, 100}, {"T01", 150, 100}},
+		},
+		{
+			name:     "second screen continues after the first, past the bezel",
+			settings: stoppedSettings(0, nil, 0),
+			tickers:  6,
+			screen:   "b",
+			want:     []boxSummary{{"T02", -60, 100}, {"T03", 40, 100}, {"T04", 140, 100}, {"T05", 240, 100}},
+		},
+		{
+			name:     "dynamic widths",
+			settings: stoppedSettings(0, []int32{120, 60, 200}, 0),
+			tickers:  3,
+			screen:   "a",
+			want:     []boxSummary{{"T00", 0, 120}, {"T01", 120, 60}, {"T02", 180, 200}},
+		},
+		{
+			name:     "tape repeats when shorter than the screen",
+			settings: stoppedSettings(0, []int32{100, 50}, 0),
+			tickers:  2,
+			screen:   "a",
+			want:     []boxSummary{{"T00", 0, 100}, {"T01", 100, 50}, {"T00", 150, 100}},
+		},
+		{
+			name:     "spacer between repetitions",
+			settings: stoppedSettings(0, []int32{100}, 50),
+			tickers:  1,
+			screen:   "a",
+			want:     []boxSummary{{"T00", 0, 100}, {"spacer", 100, 50}, {"T00", 150, 100}},
+		},
+		{
+			name:     "tickers not in the layout are skipped",
+			settings: stoppedSettings(0, []int32{100}, 0),
+			tickers:  2,
+			screen:   "a",
+			want:     []boxSummary{{"T00", 0, 100}, {"T00", 100, 100}, {"T00", 200, 100}},
+		},
+		{
+			name:     "no tickers",
+			settings: stoppedSettings(0, nil, 0),
+			tickers:  0,
+			screen:   "a",
+			want:     []boxSummary{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boxes := ScreenBoxes(tt.settings, twoScreens, testTickers(tt.tickers), tt.screen, 250, testNow)
+			if got := summarizeBoxes(boxes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScreenBoxes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTapeOffset(t *testing.T) {
+	settings := stoppedSettings(0, nil, 50)
+	settings.ScrollSpeed = 2
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		want    float64
+	}{
+		{"at the epoch", 0, 0},
+		{"scrolling", time.Second, 500},
+		{"wrapped to the tape", 3 * time.Second, 1500 - 1050},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 10 tickers and a spacer make a 1050px tape.
+			if got := TapeOffset(settings, 10, testNow.Add(tt.elapsed)); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("TapeOffset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// randomWall is a randomly generated wall, for property tests.
+type randomWall struct {
+	Settings *models.PresentationSettings
+	Cluster  *models.ScreenCluster
+	Tickers  []*models.Ticker
+}
+
+// Generate implements quick.Generator.
+func (randomWall) Generate(r *rand.Rand, size int) reflect.Value {
+	tickerCount := 1 + r.Intn(8)
+
+	// Fixed width tickers have no layout.
+	var boxWidths []int32
+	if r.Intn(2) == 0 {
+		for i := 0; i < tickerCount; i++ {
+			boxWidths = append(boxWidths, int32(50+r.Intn(500)))
+		}
+	}
+
+	var spacerWidth int32
+	if r.Intn(2) == 0 {
+		spacerWidth = int32(1 + r.Intn(300))
+	}
+
+	wall := randomWall{
+		Settings: stoppedSettings(r.Float64()*1e6, boxWidths, spacerWidth),
+		Cluster:  &models.ScreenCluster{},
+		Tickers:  testTickers(tickerCount),
+	}
+
+	rows := 1 + r.Intn(2)
+	for row := 0; row < rows; row++ {
+		screens := 1 + r.Intn(4)
+		for i := 0; i < screens; i++ {
+			wall.Cluster.Screens = append(wall.Cluster.Screens, &models.Screen{
+				UUID:       fmt.Sprintf("%d-%d", row, i),
+				Row:        int32(row),
+				Index:      int32(i),
+				Width:      int32(100 + r.Intn(1400)),
+				BezelLeft:  int32(r.Intn(20)),
+				BezelRight: int32(r.Intn(20)),
+				Gap:        int32(r.Intn(50)),
+			})
+		}
+	}
+
+	return reflect.ValueOf(wall)
+}
+
+const epsilon = 1e-6
+
+func TestScreenBoxesCoverScreens(t *testing.T) {
+	// Every screen is covered edge to edge by boxes, without any gaps or overlaps.
+	f := func(wall randomWall) bool {
+		for uuid, boxes := range WallBoxes(wall.Settings, wall.Cluster, wall.Tickers, testNow) {
+			width := float64(screenByUUID(wall.Cluster, uuid).Width)
+
+			sort.Slice(boxes, func(i, j int) bool { return boxes[i].Offset < boxes[j].Offset })
+			if len(boxes) == 0 || boxes[0].Offset > epsilon {
+				return false
+			}
+
+			last := boxes[len(boxes)-1]
+			if last.Offset+last.Width < width-epsilon {
+				return false
+			}
+
+			for i, box := range boxes {
+				if box.Offset >= width || box.Offset+box.Width <= 0 {
+					return false
+				}
+				if i > 0 && math.Abs(boxes[i-1].Offset+boxes[i-1].Width-box.Offset) > epsilon {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScreenBoxesFollowTheTape(t *testing.T) {
+	// Each box is drawn where it is on the tape, relative to where the screen is on the tape.
+	f := func(wall randomWall) bool {
+		settings := wall.Settings
+		tapeWidth := settings.TapeWidth(len(wall.Tickers))
+		tapeOffset := TapeOffset(settings, len(wall.Tickers), testNow)
+
+		for uuid, boxes := range WallBoxes(settings, wall.Cluster, wall.Tickers, testNow) {
+			screenOffset := tapeOffset + float64(wall.Cluster.ScreenGlobalOffset(uuid))
+
+			for _, box := range boxes {
+				want := settings.TickersWidth(len(wall.Tickers))
+				if !box.IsSpacer() {
+					want, _, _ = settings.TickerBox(box.Ticker)
+				}
+
+				got := models.WrapOffset(box.Offset+screenOffset, tapeWidth)
+				if math.Abs(got-want) > epsilon && math.Abs(math.Abs(got-want)-tapeWidth) > epsilon {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScreenBoxesContinueAcrossBezels(t *testing.T) {
+	// A box hanging off the right edge of a screen continues on the next screen in the row, after
+	// skipping the bezels and gap between them.
+	f := func(wall randomWall) bool {
+		boxes := WallBoxes(wall.Settings, wall.Cluster, wall.Tickers, testNow)
+
+		for _, row := range wall.Cluster.Rows() {
+			for i := 1; i < len(row); i++ {
+				left, right := row[i-1], row[i]
+				shift := float64(left.Width + left.BezelRight + left.Gap + right.BezelLeft)
+
+				for _, box := range boxes[left.UUID] {
+					offset := box.Offset - shift
+					if offset+box.Width <= 0 {
+						// Doesn't make it to the next screen.
+						continue
+					}
+					if !hasBox(boxes[right.UUID], box.Ticker, offset) {
+						return false
+					}
+				}
+			}
+		}
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func screenByUUID(cluster *models.ScreenCluster, uuid string) *models.Screen {
+	for _, screen := range cluster.Screens {
+		if screen.UUID == uuid {
+			return screen
+		}
+	}
+	return nil
+}
+
+func hasBox(boxes []Box, ticker *models.Ticker, offset float64) bool {
+	for _, box := range boxes {
+		if box.Ticker == ticker && math.Abs(box.Offset-offset) < epsilon {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package layout
+
+import "math"
+
+// TileOffsets gets where each repetition of something on the tape is drawn within a viewport. The
+// offset is where it is relative to the left edge of the viewport on one repetition of the tape,
+// and the tape repeats every tapeWidth pixels. Only repetitions which are at least partially
+// within the viewport are returned, left to right.
+func TileOffsets(offset, width, tapeWidth, viewportWidth float64) []float64 {
+	if tapeWidth <= 0 || width <= 0 {
+		return nil
+	}
+
+	// Find the left most repetition which still overlaps the viewport.
+	offset = math.Mod(offset, tapeWidth)
+	if offset < 0 {
+		offset += tapeWidth
+	}
+	if offset+width > tapeWidth {
+		offset -= tapeWidth
+	}
+
+	var offsets []float64
+	for ; offset < viewportWidth; offset += tapeWidth {
+		if offset+width > 0 {
+			offsets = append(offsets, offset)
+		}
+	}
+	return offsets
+}
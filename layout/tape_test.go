@@ -0,0 +1,81 @@
+package layout
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+func TestTileOffsets(t *testing.T) {
+	tests := []struct {
+		name          string
+		offset        float64
+		width         float64
+		tapeWidth     float64
+		viewportWidth float64
+		want          []float64
+	}{
+		{"inside the viewport", 100, 50, 1000, 500, []float64{100}},
+		{"right of the viewport", 600, 50, 1000, 500, nil},
+		{"hanging off the left edge", -20, 50, 1000, 500, []float64{-20}},
+		{"hanging off the left edge after wrapping", 980, 50, 1000, 500, []float64{-20}},
+		{"just off the left edge", -50, 50, 1000, 500, nil},
+		{"at the right edge", 500, 50, 1000, 500, nil},
+		{"offset from a previous repetition", 5100, 50, 1000, 500, []float64{100}},
+		{"negative offset from a previous repetition", -4900, 50, 1000, 500, []float64{100}},
+		{"repeated across a wide viewport", 100, 50, 1000, 2500, []float64{100, 1100, 2100}},
+		{"repeated starting off the left edge", -20, 50, 1000, 2500, []float64{-20, 980, 1980}},
+		{"as wide as the tape", 0, 1000, 1000, 2500, []float64{0, 1000, 2000}},
+		{"empty tape", 100, 50, 0, 500, nil},
+		{"empty box", 100, 0, 1000, 500, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TileOffsets(tt.offset, tt.width, tt.tapeWidth, tt.viewportWidth)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TileOffsets(%v, %v, %v, %v) = %v, want %v", tt.offset, tt.width, tt.tapeWidth, tt.viewportWidth, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTileOffsetsProperties(t *testing.T) {
+	// Every repetition which overlaps the viewport is returned, in order, and nothing else.
+	f := func(offset float64, width, tapeWidth, viewportWidth uint16) bool {
+		offset = math.Mod(offset, 1e9)
+		w := float64(width%1000) + 1
+		tape := w + float64(tapeWidth%5000)
+		viewport := float64(viewportWidth%10000) + 1
+
+		offsets := TileOffsets(offset, w, tape, viewport)
+
+		// Check against every repetition which could possibly be on screen.
+		var want []float64
+		base := math.Mod(offset, tape)
+		for k := -2.0; base+(k*tape) < viewport+tape; k++ {
+			if o := base + (k * tape); o < viewport && o+w > 0 {
+				want = append(want, o)
+			}
+		}
+		if len(offsets) != len(want) {
+			return false
+		}
+
+		for i, o := range offsets {
+			if math.Abs(o-want[i]) > 1e-6 {
+				return false
+			}
+			// One repetition after the last.
+			if i > 0 && math.Abs(o-offsets[i-1]-tape) > 1e-6 {
+				return false
+			}
+		}
+		return true
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
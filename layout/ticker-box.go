@@ -0,0 +1,95 @@
+// Package layout has the math for laying out the ticker tape, which is shared by the leader and
+// the screens so they agree on where everything is.
+package layout
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// TextMeasurer measures how wide text is, in pixels, when drawn using the given font face and size.
+type TextMeasurer interface {
+	TextWidth(face string, size float32, text string) float32
+}
+
+const (
+	// Ticker box settings.
+	TickerBoxHeight       = 240
+	TickerBoxMargin       = 30
+	TickerBoxPadding      = 50
+	TickerBoxBorderRadius = 8
+
+	// GraphSize is the width and height of the graph.
+	GraphSize = 180
+	// GraphOffset is where the graph starts in fixed width boxes, from the left of the content.
+	GraphOffset = 400
+	// GraphSpacing is the space on either side of the graph in dynamic width boxes.
+	GraphSpacing = 40
+
+	// Font faces and sizes of the two rows of text.
+	UpperRowFontFace  = "sans-bold"
+	UpperRowFontSize  = 96
+	BottomRowFontFace = "sans-light"
+	BottomRowFontSize = 58
+
+	MaxCompanyNameCharacters = 14
+
+	// WidthStep is what dynamic widths are rounded up to, so small price changes don't change the
+	// width of the box.
+	WidthStep = 20
+)
+
+// PriceText is the price, as it's displayed in the ticker box.
+func PriceText(ticker *models.Ticker) string {
+	return fmt.Sprintf("%.2f", ticker.Price)
+}
+
+// CompanyNameText is the company name, as it's displayed in the ticker box.
+func CompanyNameText(ticker *models.Ticker) string {
+	companyName := ticker.CompanyName
+	if len(companyName) >= MaxCompanyNameCharacters {
+		companyName = companyName[:(MaxCompanyNameCharacters-3)] + "..."
+	}
+	return companyName
+}
+
+// ChangeText is the price change since the previous close, as it's displayed in the ticker box.
+func ChangeText(ticker *models.Ticker) string {
+	var changePercentage float64
+	if ticker.PreviousClosePrice != 0 {
+		changePercentage = ((ticker.Price / ticker.PreviousClosePrice) - 1) * 100
+	}
+	priceDifference := ticker.Price - ticker.PreviousClosePrice
+	return fmt.Sprintf("%+.2f (%+.2f%%)", priceDifference, changePercentage)
+}
+
+// LeftColumnWidth is the width of the ticker and company name column.
+func LeftColumnWidth(m TextMeasurer, ticker *models.Ticker) float32 {
+	return float32(math.Max(
+		float64(m.TextWidth(UpperRowFontFace, UpperRowFontSize, ticker.Ticker)),
+		float64(m.TextWidth(BottomRowFontFace, BottomRowFontSize, CompanyNameText(ticker))),
+	))
+}
+
+// RightColumnWidth is the width of the price and price change column.
+func RightColumnWidth(m TextMeasurer, ticker *models.Ticker) float32 {
+	return float32(math.Max(
+		float64(m.TextWidth(UpperRowFontFace, UpperRowFontSize, PriceText(ticker))),
+		float64(m.TextWidth(BottomRowFontFace, BottomRowFontSize, ChangeText(ticker))),
+	))
+}
+
+// BoxWidth gets the width a ticker box needs to fit it's content, rounded up to WidthStep. It is
+// never narrower than minWidth.
+func BoxWidth(m TextMeasurer, ticker *models.Ticker, minWidth int32) int32 {
+	content := LeftColumnWidth(m, ticker) + GraphSpacing + GraphSize + GraphSpacing + RightColumnWidth(m, ticker)
+	width := int32(math.Ceil(float64(content))) + (2 * TickerBoxPadding) + TickerBoxMargin
+	width = ((width + WidthStep - 1) / WidthStep) * WidthStep
+
+	if width < minWidth {
+		return minWidth
+	}
+	return width
+}
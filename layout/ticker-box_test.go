@@ -0,0 +1,143 @@
+package layout
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// fixedMeasurer measures every character as half as wide as the font size, and bold text twice as wide.
+type fixedMeasurer struct{}
+
+func (fixedMeasurer) TextWidth(face string, size float32, text string) float32 {
+	width := float32(len(text)) * size / 2
+	if face == UpperRowFontFace {
+		width *= 2
+	}
+	return width
+}
+
+func TestTickerText(t *testing.T) {
+	tests := []struct {
+		name        string
+		ticker      *models.Ticker
+		price       string
+		companyName string
+		change      string
+	}{
+		{
+			name:        "up",
+			ticker:      &models.Ticker{Ticker: "AAPL", CompanyName: "Apple Inc.", Price: 110, PreviousClosePrice: 100},
+			price:       "110.00",
+			companyName: "Apple Inc.",
+			change:      "+10.00 (+10.00%)",
+		},
+		{
+			name:        "down",
+			ticker:      &models.Ticker{Ticker: "MSFT", CompanyName: "Microsoft", Price: 95.5, PreviousClosePrice: 100},
+			price:       "95.50",
+			companyName: "Microsoft",
+			change:      "-4.50 (-4.50%)",
+		},
+		{
+			name:        "long company name",
+			ticker:      &models.Ticker{Ticker: "BRK.A", CompanyName: "Berkshire Hathaway", Price: 1, PreviousClosePrice: 1},
+			price:       "1.00",
+			companyName: "Berkshire H...",
+			change:      "+0.00 (+0.00%)",
+		},
+		{
+			name:        "company name at the limit",
+			ticker:      &models.Ticker{Ticker: "XYZ", CompanyName: "Fourteen Chars"},
+			price:       "0.00",
+			companyName: "Fourteen Ch...",
+			change:      "+0.00 (+0.00%)",
+		},
+		{
+			name:        "no previous close",
+			ticker:      &models.Ticker{Ticker: "NEW", Price: 12.345},
+			price:       "12.35",
+			companyName: "",
+			change:      "+12.35 (+0.00%)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PriceText(tt.ticker); got != tt.price {
+				t.Errorf("PriceText() = %q, want %q", got, tt.price)
+			}
+			if got := CompanyNameText(tt.ticker); got != tt.companyName {
+				t.Errorf("CompanyNameText() = %q, want %q", got, tt.companyName)
+			}
+			if got := ChangeText(tt.ticker); got != tt.change {
+				t.Errorf("ChangeText() = %q, want %q", got, tt.change)
+			}
+		})
+	}
+}
+
+func TestBoxWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		ticker   *models.Ticker
+		minWidth int32
+		want     int32
+	}{
+		{
+			// Left column: "AAPL" is 4*96 = 384, right column: "110.00" is 6*96 = 576.
+			// 384 + 40 + 180 + 40 + 576 + 100 + 30 = 1350, rounded up to 1360.
+			name:     "sized to content",
+			ticker:   &models.Ticker{Ticker: "AAPL", CompanyName: "Apple Inc.", Price: 110, PreviousClosePrice: 100},
+			minWidth: 900,
+			want:     1360,
+		},
+		{
+			name:     "never narrower than the minimum",
+			ticker:   &models.Ticker{Ticker: "AAPL", CompanyName: "Apple Inc.", Price: 110, PreviousClosePrice: 100},
+			minWidth: 1500,
+			want:     1500,
+		},
+		{
+			// Left column: "BRK.A" is 5*96 = 480, right column: "500000.00" is 9*96 = 864.
+			// 480 + 40 + 180 + 40 + 864 + 100 + 30 = 1734, rounded up to 1740.
+			name:     "wide price",
+			ticker:   &models.Ticker{Ticker: "BRK.A", CompanyName: "Berkshire Hathaway", Price: 500000, PreviousClosePrice: 500000},
+			minWidth: 900,
+			want:     1740,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BoxWidth(fixedMeasurer{}, tt.ticker, tt.minWidth); got != tt.want {
+				t.Errorf("BoxWidth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBoxWidthProperties(t *testing.T) {
+	// Boxes are a multiple of the width step, fit their content and are never narrower than the minimum.
+	f := func(symbol, companyName string, price, previousClose float64, minWidth uint16) bool {
+		ticker := &models.Ticker{
+			Ticker:             symbol,
+			CompanyName:        companyName,
+			Price:              price,
+			PreviousClosePrice: previousClose,
+		}
+		min := int32(minWidth)
+
+		width := BoxWidth(fixedMeasurer{}, ticker, min)
+		content := LeftColumnWidth(fixedMeasurer{}, ticker) + RightColumnWidth(fixedMeasurer{}, ticker) + (2 * GraphSpacing) + GraphSize
+
+		return width >= min &&
+			(width == min || width%WidthStep == 0) &&
+			float32(width) >= content+(2*TickerBoxPadding)+TickerBoxMargin
+	}
+
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,15 @@
+package leader
+
+import (
+	"context"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// GetAccessControl returns the roles and users allowed to use the leader.
+func (t *Leader) GetAccessControl(ctx context.Context, req *models.Empty) (*models.AccessControl, error) {
+	if t.config.AccessControl == nil {
+		return &models.AccessControl{}, nil
+	}
+	return t.config.AccessControl, nil
+}
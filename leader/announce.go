@@ -8,18 +8,69 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// Announce will broadcast an announcement update to all clients.
+// Announce will broadcast an announcement update to all clients of the announcements wall.
 func (t *Leader) Announce(ctx context.Context, announcement *models.Announcement) (*models.Announcement, error) {
 	logrus.Debug("New Announcement..", announcement)
 
-	// Set the announcement timestamp.
-	announcement.ShowAtTimestampMS = time.Now().UnixMilli() + 200
+	// Show the announcement right away, unless it's scheduled for later.
+	if now := time.Now().UnixMilli(); announcement.ShowAtTimestampMS <= now {
+		announcement.ShowAtTimestampMS = now + 200
+	}
+	announcement.Wall = models.WallName(announcement.Wall)
 
-	// Announce to clients.
-	t.Updates <- &models.Update{
+	// Keep track of the announcement until it's done, so screens which join late still show it.
+	t.Lock()
+	wall, err := t.findWall(announcement.Wall)
+	if err != nil {
+		t.Unlock()
+		return nil, err
+	}
+	wall.pruneAnnouncements()
+	wall.Announcements = append(wall.Announcements, announcement)
+	wall.publish(&models.Update{
 		UpdateType:   int32(models.UpdateTypeAnnouncement),
 		Announcement: announcement,
-	}
+	})
+	t.Unlock()
+
+	t.audit(ctx, "Announce", wall.Name, "", nil, announcement)
 
 	return announcement, nil
 }
+
+// ListAnnouncements returns the announcements of a wall which are showing, or scheduled to be shown.
+func (t *Leader) ListAnnouncements(ctx context.Context, req *models.WallRequest) (*models.Announcements, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	wall, err := t.findWall(req.Wall)
+	if err != nil {
+		return nil, err
+	}
+
+	wall.pruneAnnouncements()
+
+	return &models.Announcements{
+		Announcements: append([]*models.Announcement(nil), wall.Announcements...),
+	}, nil
+}
+
+// pruneAnnouncements removes announcements which have finished being displayed. Must be called
+// while holding the lock.
+func (w *Wall) pruneAnnouncements() {
+	now := time.Now().UnixMilli()
+
+	pending := w.Announcements[:0]
+	for _, announcement := range w.Announcements {
+		endsAt := announcement.ShowAtTimestampMS + announcement.LifespanMS + int64(w.PresentationSettings.AnimationDurationMS)
+		if endsAt > now {
+			pending = append(pending, announcement)
+		}
+	}
+
+	// Prevent memory leak by erasing values.
+	for i := len(pending); i < len(w.Announcements); i++ {
+		w.Announcements[i] = nil
+	}
+	w.Announcements = pending
+}
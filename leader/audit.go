@@ -0,0 +1,230 @@
+package leader
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// auditLogSize is how many of the most recent audit entries are kept for GetAuditLog. The audit
+	// file has all of them.
+	auditLogSize = 1000
+
+	// defaultAuditLimit is how many entries GetAuditLog returns when no limit is given.
+	defaultAuditLimit = 100
+
+	// maxAuditLineSize is the longest line read from the audit file.
+	maxAuditLineSize = 1024 * 1024
+)
+
+// auditLog records the changes made to the walls. Each entry is appended to the audit file as a
+// line of JSON, and the most recent entries are kept in memory.
+type auditLog struct {
+	sync.Mutex
+	file    string // Empty when entries are only kept in memory.
+	entries []*models.AuditEntry
+}
+
+// newAuditLog creates the audit log, loading the most recent entries of the audit file.
+func newAuditLog(file string) (*auditLog, error) {
+	log := &auditLog{file: file}
+	if file == "" {
+		return log, nil
+	}
+
+	f, err := os.Open(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return log, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to open audit file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAuditLineSize)
+	for scanner.Scan() {
+		entry := &models.AuditEntry{}
+		if err := protojson.Unmarshal(scanner.Bytes(), entry); err != nil {
+			logrus.WithError(err).Warn("Skipping invalid audit file entry.")
+			continue
+		}
+		log.keep(entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read audit file: %w", err)
+	}
+
+	return log, nil
+}
+
+// add records an entry. Failing to write it to the audit file is logged, the change has already
+// been made.
+func (a *auditLog) add(entry *models.AuditEntry) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.keep(entry)
+
+	if a.file == "" {
+		return
+	}
+	if err := a.write(entry); err != nil {
+		logrus.WithError(err).Error("Unable to write to audit file.")
+	}
+}
+
+// keep adds the entry to the most recent entries. Must be called while holding the lock.
+func (a *auditLog) keep(entry *models.AuditEntry) {
+	a.entries = append(a.entries, entry)
+	if len(a.entries) > auditLogSize {
+		// Copy, so the dropped entries don't stay in the backing array.
+		a.entries = append([]*models.AuditEntry(nil), a.entries[len(a.entries)-auditLogSize:]...)
+	}
+}
+
+// write appends the entry to the audit file. It's opened for each entry, so it can be rotated.
+func (a *auditLog) write(entry *models.AuditEntry) error {
+	data, err := protojson.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(a.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// list returns the most recent entries matching the request, oldest first.
+func (a *auditLog) list(req *models.AuditLogRequest) []*models.AuditEntry {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultAuditLimit
+	}
+
+	a.Lock()
+	defer a.Unlock()
+
+	var entries []*models.AuditEntry
+	for i := len(a.entries) - 1; i >= 0 && len(entries) < limit; i-- {
+		entry := a.entries[i]
+		if req.Wall != "" && entry.Wall != models.WallName(req.Wall) {
+			continue
+		}
+		if req.Action != "" && !strings.EqualFold(entry.Action, req.Action) {
+			continue
+		}
+		if req.User != "" && entry.User != req.User {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	// Oldest first.
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	return entries
+}
+
+// GetAuditLog returns the most recent changes made to the walls, oldest first.
+func (t *Leader) GetAuditLog(ctx context.Context, req *models.AuditLogRequest) (*models.AuditLog, error) {
+	return &models.AuditLog{
+		Entries: t.audits.list(req),
+	}, nil
+}
+
+// audit records a change made by a request. Before or after is nil when there wasn't a value, eg:
+// adding a ticker. This shouldn't be called while holding the leaders lock.
+func (t *Leader) audit(ctx context.Context, action, wall, target string, before, after proto.Message) {
+	entry := &models.AuditEntry{
+		TimestampMS: time.Now().UnixMilli(),
+		Action:      action,
+		Wall:        wall,
+		Target:      target,
+	}
+
+	if id, ok := auth.FromContext(ctx); ok {
+		entry.User = id.Name
+		entry.Role = id.Role
+	}
+
+	var err error
+	if before != nil {
+		if entry.Before, err = anypb.New(before); err != nil {
+			logrus.WithError(err).Error("Unable to audit value before change.")
+		}
+	}
+	if after != nil {
+		if entry.After, err = anypb.New(after); err != nil {
+			logrus.WithError(err).Error("Unable to audit value after change.")
+		}
+	}
+	if before != nil && after != nil {
+		entry.Changed = changedFields(before, after)
+	}
+
+	t.audits.add(entry)
+}
+
+// changedFields returns the names of the fields which are different between two messages of the
+// same type.
+func changedFields(before, after proto.Message) []string {
+	b, a := before.ProtoReflect(), after.ProtoReflect()
+	fields := b.Descriptor().Fields()
+
+	var changed []string
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+
+		// Compare messages with only this field set.
+		bField, aField := b.New(), a.New()
+		if b.Has(fd) {
+			bField.Set(fd, b.Get(fd))
+		}
+		if a.Has(fd) {
+			aField.Set(fd, a.Get(fd))
+		}
+
+		if !proto.Equal(bField.Interface(), aField.Interface()) {
+			changed = append(changed, string(fd.Name()))
+		}
+	}
+	return changed
+}
+
+// auditedSettings is the part of presentation settings which is audited. The scroll epoch and
+// ticker layout are managed by the leader, and change all the time.
+func auditedSettings(settings *models.PresentationSettings) *models.PresentationSettings {
+	audited := proto.Clone(settings).(*models.PresentationSettings)
+	audited.ScrollEpoch = nil
+	audited.TickerLayout = nil
+	return audited
+}
+
+// auditedTicker is the part of a ticker which is audited, without it's price data.
+func auditedTicker(ticker *models.Ticker) *models.Ticker {
+	return &models.Ticker{
+		Ticker:      ticker.Ticker,
+		CompanyName: ticker.CompanyName,
+	}
+}
@@ -0,0 +1,96 @@
+package leader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestChangedFields(t *testing.T) {
+	before := &models.PresentationSettings{ScrollSpeed: 5, UpColor: &models.RGBA{Green: 255}, TickerBoxWidth: 1100}
+	after := &models.PresentationSettings{ScrollSpeed: 2, UpColor: &models.RGBA{Green: 200}, TickerBoxWidth: 1100, ShowLogos: true}
+
+	if got := strings.Join(changedFields(before, after), ","); got != "ScrollSpeed,UpColor,ShowLogos" {
+		t.Errorf("changedFields() = %s, want ScrollSpeed,UpColor,ShowLogos", got)
+	}
+	if got := changedFields(before, proto.Clone(before)); len(got) != 0 {
+		t.Errorf("changedFields() of the same settings = %v, want none", got)
+	}
+}
+
+func TestAuditLogRoundTrip(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.jsonl")
+	audits, err := newAuditLog(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leader := &Leader{audits: audits}
+
+	access := &auth.Config{Access: auth.AccessControl{
+		Users: []auth.User{{Name: "alice", Token: "alice-token", Role: auth.RoleOperator}},
+	}}
+	alice, err := access.Authenticate("Bearer alice-token", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := auth.NewContext(context.Background(), alice)
+
+	leader.audit(ctx, "AddTicker", "lobby", "TSLA", nil, &models.Ticker{Ticker: "TSLA"})
+	leader.audit(ctx, "UpdatePresentationSettings", "lobby", "",
+		&models.PresentationSettings{ScrollSpeed: 5}, &models.PresentationSettings{ScrollSpeed: 2})
+	leader.audit(context.Background(), "Announce", models.DefaultWall, "", nil, &models.Announcement{Message: "Hi"})
+
+	// A restarted leader loads the entries back from the audit file.
+	restored, err := newAuditLog(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := restored.list(&models.AuditLogRequest{})
+	if len(entries) != 3 {
+		t.Fatalf("restored %d entries, want 3", len(entries))
+	}
+	for i, entry := range audits.list(&models.AuditLogRequest{}) {
+		if !proto.Equal(entries[i], entry) {
+			t.Errorf("restored entry %d = %v, want %v", i, entries[i], entry)
+		}
+	}
+
+	settings := entries[1]
+	if settings.User != "alice" || settings.Role != auth.RoleOperator || strings.Join(settings.Changed, ",") != "ScrollSpeed" {
+		t.Errorf("settings entry = %v, want alice changing ScrollSpeed", settings)
+	}
+	before := &models.PresentationSettings{}
+	if err := settings.Before.UnmarshalTo(before); err != nil || before.ScrollSpeed != 5 {
+		t.Errorf("settings before = %v, %v, want a scroll speed of 5", before, err)
+	}
+
+	// Filters.
+	if got := restored.list(&models.AuditLogRequest{Wall: "Lobby"}); len(got) != 2 {
+		t.Errorf("lobby entries = %d, want 2", len(got))
+	}
+	if got := restored.list(&models.AuditLogRequest{Action: "announce"}); len(got) != 1 || got[0].User != "" {
+		t.Errorf("announce entries = %v, want the anonymous announcement", got)
+	}
+	if got := restored.list(&models.AuditLogRequest{User: "alice", Limit: 1}); len(got) != 1 || got[0].Action != "UpdatePresentationSettings" {
+		t.Errorf("alice's last entry = %v, want the settings change", got)
+	}
+
+	// Invalid lines are skipped, rather than losing the whole log.
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.WriteString("not json\n"); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	if restored, err = newAuditLog(file); err != nil || len(restored.list(&models.AuditLogRequest{})) != 3 {
+		t.Errorf("newAuditLog() with an invalid line = %v, want the 3 valid entries", err)
+	}
+}
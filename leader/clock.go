@@ -0,0 +1,39 @@
+package leader
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// SyncClock responds with the leaders clock, so screens can estimate how far their own clock is
+// off. The leaders clock is the reference clock for the whole cluster.
+func (t *Leader) SyncClock(ctx context.Context, req *models.ClockSyncRequest) (*models.ClockSyncResponse, error) {
+	receivedAt := time.Now().UnixNano()
+
+	// Keep track of the screens last measurement, so it can be displayed.
+	if req.ScreenUUID != "" {
+		t.Lock()
+		for _, wall := range t.Walls {
+			for _, client := range wall.Clients {
+				if client.Screen.UUID == req.ScreenUUID {
+					// Copy it, the current screen may be in the middle of being sent to clients.
+					screen := proto.Clone(client.Screen).(*models.Screen)
+					screen.ClockOffsetNS = req.ClockOffsetNS
+					screen.ClockRoundTripNS = req.ClockRoundTripNS
+					client.Screen = screen
+					break
+				}
+			}
+		}
+		t.Unlock()
+	}
+
+	return &models.ClockSyncResponse{
+		ClientTransmitNS: req.ClientTransmitNS,
+		LeaderReceiveNS:  receivedAt,
+		LeaderTransmitNS: time.Now().UnixNano(),
+	}, nil
+}
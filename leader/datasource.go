@@ -0,0 +1,58 @@
+package leader
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	polygon "github.com/polygon-io/go-app-ticker-wall/polygon_client"
+	sim "github.com/polygon-io/go-app-ticker-wall/sim_client"
+)
+
+// DataSource provides the market data the leader distributes to the cluster. The Polygon.io
+// client is the default implementation, but any feed that can provide ticker details, aggregates
+// and a stream of price updates can be plugged in via Config.DataSource.
+type DataSource interface {
+	// LoadTickerData gets the company details, previous close and current price of a ticker.
+	LoadTickerData(ctx context.Context, tickerSymbol string) (*models.Ticker, error)
+
+	// GetTickerTodayAggs gets the aggregates for a ticker on the given day, in bars of rangeSize minutes.
+	GetTickerTodayAggs(ctx context.Context, t time.Time, ticker string, rangeSize int) ([]*models.Agg, error)
+
+	// ListenForTickerUpdates streams price updates for the given tickers onto the PriceUpdates
+	// channel. It blocks until the context is done or the stream fails.
+	ListenForTickerUpdates(ctx context.Context, tickers []string) error
+
+	// Subscribe adds tickers to the price update stream, while it's running.
+	Subscribe(tickers ...string) error
+
+	// Unsubscribe removes tickers from the price update stream, while it's running.
+	Unsubscribe(tickers ...string) error
+
+	// PriceUpdates is the channel real-time price updates are published on.
+	PriceUpdates() <-chan *models.PriceUpdate
+}
+
+// Make sure our built in data sources satisfy the interface.
+var (
+	_ DataSource = (*polygon.Client)(nil)
+	_ DataSource = (*sim.Client)(nil)
+)
+
+// newDataSource creates the data source described by the config.
+func newDataSource(cfg *Config) (DataSource, error) {
+	// An externally created data source always wins.
+	if cfg.DataSource != nil {
+		return cfg.DataSource, nil
+	}
+
+	switch cfg.Source {
+	case SourcePolygon, "":
+		return polygon.NewClient(cfg.APIKey, cfg.Presentation.PerTickUpdates)
+	case SourceSim:
+		return sim.NewClient(cfg.Seed, cfg.Presentation.PerTickUpdates), nil
+	default:
+		return nil, fmt.Errorf("unknown data source: %s", cfg.Source)
+	}
+}
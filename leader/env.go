@@ -2,11 +2,50 @@ package leader
 
 import "github.com/polygon-io/go-app-ticker-wall/models"
 
+// Data sources which can be selected with Config.Source.
+const (
+	// SourcePolygon streams real market data from Polygon.io, this requires an API key.
+	SourcePolygon = "polygon"
+	// SourceSim generates simulated market data using a seeded random walk.
+	SourceSim = "sim"
+)
+
 // Config handles the default settings, as well as data client auth.
 type Config struct {
 	TickerList string
 	APIKey     string
 
+	// WallList is a comma separated list of walls to serve, besides the default wall. Walls which
+	// aren't in the list or the saved state can only be created with CreateWall.
+	WallList string
+
+	// Source is which built in data source to use ( polygon, sim ). Defaults to polygon.
+	Source string
+	// Seed is used to seed the simulated data source. 0 picks a random seed.
+	Seed int64
+
+	// ReplayFile replays a recording instead of using a data source when set.
+	ReplayFile string
+	// ReplaySpeed scales the speed of the replay, 2 is twice as fast as it was recorded.
+	ReplaySpeed float64
+	// ReplayLoop starts the replay over again once the end of the recording is reached.
+	ReplayLoop bool
+
+	// StateFile is where the leader saves its state, so it can be restored after a restart.
+	// State is not saved when empty.
+	StateFile string
+
+	// AuditFile is where the changes made to the walls are logged, as JSON lines. The most recent
+	// changes are still kept in memory when empty.
+	AuditFile string
+
+	// DataSource overrides the default Polygon.io data client when set.
+	DataSource DataSource
+
 	// Presentation Default Settings
 	Presentation *models.PresentationSettings
+
+	// AccessControl is returned by GetAccessControl. The leader doesn't check access itself, the
+	// servers in front of it do.
+	AccessControl *models.AccessControl
 }
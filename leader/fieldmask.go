@@ -0,0 +1,71 @@
+package leader
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// applyFieldMask copies the fields listed in paths from src to dst. Paths are field names, nested
+// fields are separated by a dot ( eg: "UpColor.Red" ). No paths copies every field. The paths are
+// all validated before anything is copied, so dst is never partially updated.
+func applyFieldMask(dst, src proto.Message, paths []string) error {
+	if len(paths) == 0 {
+		proto.Reset(dst)
+		proto.Merge(dst, src)
+		return nil
+	}
+
+	for _, path := range paths {
+		if _, err := resolvePath(dst.ProtoReflect().Descriptor(), path); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range paths {
+		copyPath(dst.ProtoReflect(), src.ProtoReflect(), strings.Split(path, "."))
+	}
+
+	return nil
+}
+
+// resolvePath finds the field a path refers to.
+func resolvePath(md protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	var fd protoreflect.FieldDescriptor
+	for i, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil, fmt.Errorf("%s: %s is not a message", path, strings.Join(strings.Split(path, ".")[:i], "."))
+		}
+
+		fd = md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("%s: unknown field %s", path, name)
+		}
+
+		if fd.IsList() || fd.IsMap() {
+			md = nil
+		} else {
+			md = fd.Message()
+		}
+	}
+
+	return fd, nil
+}
+
+// copyPath copies a single field, creating any parent messages in dst along the way.
+func copyPath(dst, src protoreflect.Message, path []string) {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+
+	if len(path) == 1 {
+		if !src.Has(fd) {
+			dst.Clear(fd)
+			return
+		}
+		dst.Set(fd, src.Get(fd))
+		return
+	}
+
+	copyPath(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:])
+}
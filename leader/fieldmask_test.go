@@ -0,0 +1,89 @@
+package leader
+
+import (
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestApplyFieldMask(t *testing.T) {
+	current := func() *models.PresentationSettings {
+		return &models.PresentationSettings{
+			ScrollSpeed: 5,
+			UpColor:     &models.RGBA{Red: 10, Green: 255, Alpha: 255},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		src     *models.PresentationSettings
+		paths   []string
+		want    *models.PresentationSettings
+		wantErr bool
+	}{
+		{
+			name:  "empty mask replaces everything",
+			src:   &models.PresentationSettings{ScrollSpeed: 2},
+			paths: nil,
+			want:  &models.PresentationSettings{ScrollSpeed: 2},
+		},
+		{
+			name:  "top level field",
+			src:   &models.PresentationSettings{ScrollSpeed: 2},
+			paths: []string{"ScrollSpeed"},
+			want:  &models.PresentationSettings{ScrollSpeed: 2, UpColor: &models.RGBA{Red: 10, Green: 255, Alpha: 255}},
+		},
+		{
+			name:  "set to zero",
+			src:   &models.PresentationSettings{},
+			paths: []string{"ScrollSpeed"},
+			want:  &models.PresentationSettings{UpColor: &models.RGBA{Red: 10, Green: 255, Alpha: 255}},
+		},
+		{
+			name:  "nested field",
+			src:   &models.PresentationSettings{UpColor: &models.RGBA{Red: 20}},
+			paths: []string{"UpColor.Red"},
+			want:  &models.PresentationSettings{ScrollSpeed: 5, UpColor: &models.RGBA{Red: 20, Green: 255, Alpha: 255}},
+		},
+		{
+			name:  "nested field of an unset message",
+			src:   &models.PresentationSettings{},
+			paths: []string{"UpColor.Red"},
+			want:  &models.PresentationSettings{ScrollSpeed: 5, UpColor: &models.RGBA{Green: 255, Alpha: 255}},
+		},
+		{
+			name:  "whole message",
+			src:   &models.PresentationSettings{UpColor: &models.RGBA{}},
+			paths: []string{"UpColor"},
+			want:  &models.PresentationSettings{ScrollSpeed: 5, UpColor: &models.RGBA{}},
+		},
+		{
+			name:    "unknown field isn't partially applied",
+			src:     &models.PresentationSettings{ScrollSpeed: 2},
+			paths:   []string{"ScrollSpeed", "Recolor"},
+			want:    current(),
+			wantErr: true,
+		},
+		{
+			name:    "path through a scalar",
+			src:     &models.PresentationSettings{},
+			paths:   []string{"ScrollSpeed.Red"},
+			want:    current(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := current()
+			err := applyFieldMask(dst, tt.src, tt.paths)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyFieldMask() error = %v, want error %v", err, tt.wantErr)
+			}
+			if !proto.Equal(dst, tt.want) {
+				t.Errorf("applyFieldMask() = %v, want %v", dst, tt.want)
+			}
+		})
+	}
+}
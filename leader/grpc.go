@@ -2,13 +2,31 @@ package leader
 
 import (
 	"context"
+	"time"
 
+	"github.com/polygon-io/go-app-ticker-wall/auth"
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// JoinCluster streams the walls updates to a screen with the v1 protocol, which screens fall back to
+// when the leader doesn't support a newer one.
 func (t *Leader) JoinCluster(screen *models.Screen, stream models.Leader_JoinClusterServer) error {
+	screen.ProtocolVersion = models.ProtocolV1
+	return t.ServeScreen(stream.Context(), screen, stream.Send)
+}
+
+// ServeScreen adds the screen to it's wall, and sends it a snapshot of the wall followed by the walls
+// updates until the context is done or sending fails. The screen is removed from the wall when this
+// returns. This is what JoinCluster does for gRPC screens, and is shared by the other ways screens
+// can join. The screens ProtocolVersion should be set to the protocol send uses.
+func (t *Leader) ServeScreen(ctx context.Context, screen *models.Screen, send func(*models.Update) error) error {
+	screen.Wall = models.WallName(screen.Wall)
 	logrus.WithFields(logrus.Fields{
+		"wall":   screen.Wall,
 		"uuid":   screen.UUID,
 		"index":  screen.Index,
 		"width":  screen.Width,
@@ -17,13 +35,18 @@ func (t *Leader) JoinCluster(screen *models.Screen, stream models.Leader_JoinClu
 
 	// Create update client
 	client := &UpdateClient{
-		Screen:  screen,
-		Stream:  stream,
-		Updates: make(chan *models.Update, 100), // dont block.
+		Screen: screen,
+		queue:  newSendQueue(),
+		kicked: make(chan struct{}),
+	}
+	if id, ok := auth.FromContext(ctx); ok && !id.Anonymous() {
+		client.Owner = id.Name
 	}
 
-	// Add new screen client.
-	t.addScreenToCluster(client)
+	// Add new screen client, which catches it up with a snapshot of the wall.
+	if err := t.addScreenToCluster(client); err != nil {
+		return err
+	}
 
 	logrus.Debug("Screen added")
 
@@ -34,36 +57,99 @@ func (t *Leader) JoinCluster(screen *models.Screen, stream models.Leader_JoinClu
 		}
 	}()
 
+	// Pushing updates checks if the screen is behind, but a quiet wall may not push anything.
+	behindTimer := time.NewTicker(behindCheckInterval)
+	defer behindTimer.Stop()
+
 	for {
 		select {
-		case <-stream.Context().Done():
+		case <-ctx.Done():
 			// Client has disconnected.
 			logrus.WithField("client", client.Screen.UUID).Debug("Client has disconnected.")
 			return nil
-		case update, ok := <-client.Updates:
-			if !ok {
-				return nil
-			}
+		case <-client.kicked:
+			return status.Error(codes.Aborted, "the screen was kicked from the wall")
+		case now := <-behindTimer.C:
+			client.queue.checkBehind(now)
+		case <-client.queue.behind:
+			t.slowScreenDisconnected(client)
+			return status.Error(codes.ResourceExhausted, "the screen fell too far behind")
+		case <-client.queue.ready:
+			// Send everything which is waiting.
+			for {
+				update, ok := client.queue.pop()
+				if !ok {
+					break
+				}
 
-			// logrus.WithField("client", client.Screen.UUID).Debug("Sending Client Update")
-			if err := client.Stream.Send(update); err != nil {
-				return err
+				// logrus.WithField("client", client.Screen.UUID).Debug("Sending Client Update")
+				if err := send(update); err != nil {
+					return err
+				}
 			}
 		}
 	}
 }
 
-// GetScreenCluster returns our current screen cluster.
-func (t *Leader) GetScreenCluster(ctx context.Context, empty *models.Empty) (*models.ScreenCluster, error) {
-	return t.CurrentScreenCluster(), nil
+// slowScreenDisconnected counts a screen disconnected for falling too far behind.
+func (t *Leader) slowScreenDisconnected(client *UpdateClient) {
+	logrus.WithFields(logrus.Fields{
+		"wall": client.Screen.Wall,
+		"uuid": client.Screen.UUID,
+	}).Warn("Disconnecting screen, it fell too far behind.")
+
+	t.Lock()
+	defer t.Unlock()
+
+	if wall, err := t.findWall(client.Screen.Wall); err == nil {
+		wall.slowScreensDisconnected++
+	}
 }
 
-// GetTickers returns our current state of ticker data.
-func (t *Leader) GetTickers(ctx context.Context, empty *models.Empty) (*models.Tickers, error) {
+// GetScreenCluster returns the current screen cluster of a wall.
+func (t *Leader) GetScreenCluster(ctx context.Context, req *models.WallRequest) (*models.ScreenCluster, error) {
+	return t.CurrentScreenCluster(req.Wall)
+}
+
+// GetTickers returns the current state of a walls ticker data.
+func (t *Leader) GetTickers(ctx context.Context, req *models.WallRequest) (*models.Tickers, error) {
 	t.RLock()
 	defer t.RUnlock()
 
-	return &models.Tickers{
-		Tickers: t.Tickers,
-	}, nil
+	wall, err := t.findWall(req.Wall)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copied, the tickers and their prices keep changing while the response is being sent.
+	res := &models.Tickers{}
+	for _, ticker := range wall.Tickers {
+		res.Tickers = append(res.Tickers, proto.Clone(ticker).(*models.Ticker))
+	}
+
+	return res, nil
+}
+
+// ListTickers returns the current list of a walls tickers, without their aggregates.
+func (t *Leader) ListTickers(ctx context.Context, req *models.WallRequest) (*models.Tickers, error) {
+	t.RLock()
+	defer t.RUnlock()
+
+	wall, err := t.findWall(req.Wall)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &models.Tickers{}
+	for _, ticker := range wall.Tickers {
+		res.Tickers = append(res.Tickers, &models.Ticker{
+			Ticker:             ticker.Ticker,
+			CompanyName:        ticker.CompanyName,
+			OutstandingShares:  ticker.OutstandingShares,
+			Price:              ticker.Price,
+			PreviousClosePrice: ticker.PreviousClosePrice,
+		})
+	}
+
+	return res, nil
 }
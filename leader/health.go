@@ -0,0 +1,104 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+// sourceHealth keeps track of how the data source is doing, so it can be shown on the admin dashboard.
+type sourceHealth struct {
+	sync.Mutex
+	health *models.DataSourceHealth
+}
+
+// newSourceHealth starts keeping track of the named data source.
+func newSourceHealth(source string) *sourceHealth {
+	return &sourceHealth{
+		health: &models.DataSourceHealth{
+			Source:    source,
+			StartedMS: time.Now().UnixMilli(),
+		},
+	}
+}
+
+// sourceName is the name of the configured data source, for the health report.
+func sourceName(cfg *Config) string {
+	switch {
+	case cfg.ReplayFile != "":
+		return "replay"
+	case cfg.DataSource != nil:
+		return "custom"
+	case cfg.Source == "":
+		return SourcePolygon
+	}
+	return cfg.Source
+}
+
+func (h *sourceHealth) setStreaming(streaming bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	h.health.Streaming = streaming
+}
+
+func (h *sourceHealth) priceUpdated() {
+	h.Lock()
+	defer h.Unlock()
+
+	h.health.PriceUpdates++
+	h.health.LastPriceUpdateMS = time.Now().UnixMilli()
+}
+
+func (h *sourceHealth) aggsRefreshed() {
+	h.Lock()
+	defer h.Unlock()
+
+	h.health.LastAggsRefreshMS = time.Now().UnixMilli()
+}
+
+func (h *sourceHealth) detailsRefreshed() {
+	h.Lock()
+	defer h.Unlock()
+
+	h.health.LastDetailsRefreshMS = time.Now().UnixMilli()
+}
+
+// failed records an error from the data source. Nil errors are ignored, so the result of a call
+// can be passed straight in.
+func (h *sourceHealth) failed(err error) {
+	if err == nil {
+		return
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	h.health.LastError = err.Error()
+	h.health.LastErrorMS = time.Now().UnixMilli()
+}
+
+// GetDataSourceHealth reports how the data source is doing.
+func (t *Leader) GetDataSourceHealth(ctx context.Context, req *models.Empty) (*models.DataSourceHealth, error) {
+	t.health.Lock()
+	defer t.health.Unlock()
+
+	return proto.Clone(t.health.health).(*models.DataSourceHealth), nil
+}
+
+// listenForTickerUpdates runs the data source's price update stream, keeping track of it's health.
+func (t *Leader) listenForTickerUpdates(ctx context.Context) error {
+	t.health.setStreaming(true)
+	defer t.health.setStreaming(false)
+
+	err := t.DataClient.ListenForTickerUpdates(ctx, t.getTickerSymbols())
+	if ctx.Err() == nil {
+		t.health.failed(err)
+		logrus.WithError(err).Error("Price update stream ended.")
+	}
+	return err
+}
@@ -5,9 +5,11 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/polygon-io/go-app-ticker-wall/fonts"
+	"github.com/polygon-io/go-app-ticker-wall/layout"
 	"github.com/polygon-io/go-app-ticker-wall/models"
-	polygon "github.com/polygon-io/go-app-ticker-wall/polygon_client"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 	tombv2 "gopkg.in/tomb.v2"
 )
 
@@ -16,41 +18,74 @@ type Leader struct {
 	sync.RWMutex
 	config Config
 
-	// Client to fetch data. We should use an interface here to allow more flexibility.
-	DataClient *polygon.Client
+	// Client to fetch data.
+	DataClient DataSource
 
-	// This keeps the presentation settings.
-	PresentationSettings *models.PresentationSettings
+	// Walls we are serving, by name. There is always a default wall.
+	Walls map[string]*Wall
 
-	// Our list of tickers we want to display.
-	Tickers []*models.Ticker
+	// Measures ticker content, to size ticker boxes.
+	measurer layout.TextMeasurer
 
-	// List of clients who are listening for updates.
-	Clients []*UpdateClient
+	// How the data source is doing.
+	health *sourceHealth
+
+	// Who changed what.
+	audits *auditLog
 
 	// Updates is a buffered channel of generic updates to be broadcast to clients.
-	// Every update added to this channel will be sent to all active clients.
+	// Every update added to this channel will be sent to all active clients of the updates wall,
+	// or every client when the update has no wall.
 	Updates chan *models.Update
 }
 
 // New creates a new ticker wall leader.
 func New(cfg *Config) (*Leader, error) {
+	defaultWall := newWall(models.DefaultWall, cfg.Presentation)
 	obj := &Leader{
-		config:               *cfg,
-		PresentationSettings: cfg.Presentation,
-		Updates:              make(chan *models.Update, 1000),
+		config:   *cfg,
+		Walls:    map[string]*Wall{models.DefaultWall: defaultWall},
+		Updates:  make(chan *models.Update, 1000),
+		measurer: fonts.NewMeasurer(),
+		health:   newSourceHealth(sourceName(cfg)),
+	}
+
+	// Changes are audited while replaying too, only the tickers come from the recording.
+	var err error
+	if obj.audits, err = newAuditLog(cfg.AuditFile); err != nil {
+		return nil, err
+	}
+
+	// When replaying, the tickers come from the recording and there is no data source. We also
+	// don't persist state, the replay shouldn't overwrite the state of the live ticker wall.
+	if obj.config.ReplayFile != "" {
+		obj.config.StateFile = ""
+		return obj, nil
 	}
 
 	// Split out the tickers from the config.
 	for _, ticker := range strings.Split(obj.config.TickerList, ",") {
-		obj.Tickers = append(obj.Tickers, &models.Ticker{
+		defaultWall.Tickers = append(defaultWall.Tickers, &models.Ticker{
 			Ticker: ticker,
 		})
 	}
 
-	// Create new Polygon API Client.
-	var err error
-	obj.DataClient, err = polygon.NewClient(cfg.APIKey, cfg.Presentation.PerTickUpdates)
+	// Restore any state saved by a previous run.
+	if obj.config.StateFile != "" {
+		if err := obj.restoreState(); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the walls from the config, which start as a copy of the default wall.
+	for _, name := range strings.Split(obj.config.WallList, ",") {
+		if strings.TrimSpace(name) != "" {
+			obj.createWall(name)
+		}
+	}
+
+	// Create the market data client.
+	obj.DataClient, err = newDataSource(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -59,16 +94,27 @@ func New(cfg *Config) (*Leader, error) {
 }
 
 func (t *Leader) Run(ctx context.Context) error {
+	if t.config.ReplayFile != "" {
+		return t.runReplay(ctx)
+	}
+
 	logrus.Info("Loading ticker data..")
 
 	if err := t.refreshTickerDetails(ctx, true); err != nil {
+		t.health.failed(err)
 		return err
 	}
+	t.health.detailsRefreshed()
 
 	// Get graph data for all aggs on load.
 	if err := t.refreshTickerAggs(ctx); err != nil {
+		t.health.failed(err)
 		return err
 	}
+	t.health.aggsRefreshed()
+
+	// Size the ticker boxes now that we know what is in them.
+	t.refreshTickerLayouts()
 
 	logrus.Debug("All ticker data loaded..")
 	logrus.Info("Ready for Clients.")
@@ -79,7 +125,7 @@ func (t *Leader) Run(ctx context.Context) error {
 	// Start the DataClient socket stream.
 	tomb.Go(func() error {
 		logrus.Debug("Starting WebSocket Listener..")
-		return t.DataClient.ListenForTickerUpdates(ctx, t.getTickerSymbols())
+		return t.listenForTickerUpdates(ctx)
 	})
 
 	// Listen and broadcast price updates.
@@ -102,16 +148,87 @@ func (t *Leader) Run(ctx context.Context) error {
 		return t.tickerDetailsUpdateLoop(ctx)
 	})
 
+	// Resize ticker boxes as their content changes.
+	tomb.Go(func() error {
+		return t.tickerLayoutLoop(ctx)
+	})
+
+	// Save our state when it changes.
+	if t.config.StateFile != "" {
+		tomb.Go(func() error {
+			return t.stateSaveLoop(ctx)
+		})
+	}
+
 	return tomb.Wait()
 }
 
-// getTickerSymbols returns a slice of only the ticker symbols, not the entire object.
+// getTickerSymbols returns a slice of only the ticker symbols on any wall, not the entire object.
 func (t *Leader) getTickerSymbols() []string {
-	tickers := make([]string, 0, len(t.Tickers))
-
-	for _, ticker := range t.Tickers {
-		tickers = append(tickers, ticker.Ticker)
+	t.RLock()
+	defer t.RUnlock()
+
+	var tickers []string
+	seen := make(map[string]bool)
+
+	for _, name := range t.wallNames() {
+		for _, ticker := range t.Walls[name].Tickers {
+			if seen[ticker.Ticker] {
+				continue
+			}
+			seen[ticker.Ticker] = true
+			tickers = append(tickers, ticker.Ticker)
+		}
 	}
 
 	return tickers
 }
+
+// isTickerOnAnyWall checks if any wall is displaying the ticker. Must be called while holding the lock.
+func (t *Leader) isTickerOnAnyWall(symbol string) bool {
+	for _, wall := range t.Walls {
+		if wall.hasTicker(symbol) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateTicker calls update on a copy of the ticker on each wall which has it, and publishes the
+// tickers which update says have changed. The tickers are replaced rather than changed, the
+// current ones may be in the middle of being sent to clients.
+func (t *Leader) updateTicker(symbol string, update func(ticker *models.Ticker) bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, wall := range t.Walls {
+		for i, ticker := range wall.Tickers {
+			if ticker.Ticker != symbol {
+				continue
+			}
+
+			updated := proto.Clone(ticker).(*models.Ticker)
+			if !update(updated) {
+				continue
+			}
+			wall.Tickers[i] = updated
+			wall.publish(&models.Update{
+				UpdateType: int32(models.UpdateTypeTickerUpdate),
+				Ticker:     proto.Clone(updated).(*models.Ticker),
+			})
+		}
+	}
+}
+
+// setPrice keeps track of a tickers price, the width of a ticker's box depends on it. The ticker is
+// replaced rather than changed, the current one may be in the middle of being sent to clients.
+// Must be called while holding the write lock.
+func (w *Wall) setPrice(symbol string, price float64) {
+	for i, ticker := range w.Tickers {
+		if ticker.Ticker == symbol {
+			updated := proto.Clone(ticker).(*models.Ticker)
+			updated.Price = price
+			w.Tickers[i] = updated
+		}
+	}
+}
@@ -15,7 +15,16 @@ func (t *Leader) broadcastPriceUpdatesLoop(ctx context.Context) error {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case priceUpdate := <-t.DataClient.PriceUpdates:
+		case priceUpdate := <-t.DataClient.PriceUpdates():
+			t.health.priceUpdated()
+
+			// Keep track of the price, the width of a ticker's box depends on it.
+			t.Lock()
+			for _, wall := range t.Walls {
+				wall.setPrice(priceUpdate.Ticker, priceUpdate.Price)
+			}
+			t.Unlock()
+
 			t.Updates <- &models.Update{
 				UpdateType:  int32(models.UpdateTypePrice),
 				PriceUpdate: priceUpdate,
@@ -32,11 +41,19 @@ func (t *Leader) clientUpdateLoop(ctx context.Context) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case update := <-t.Updates:
+			now := time.Now()
 			t.RLock()
 
-			// Put this update on the clients queue.
-			for _, client := range t.Clients {
-				client.Updates <- update
+			// Put this update on the queue of each client of the updates wall. This doesn't block,
+			// slow clients are dealt with by their queue.
+			for _, wall := range t.Walls {
+				if update.Wall != "" && update.Wall != wall.Name {
+					continue
+				}
+
+				for _, client := range wall.Clients {
+					client.queue.push(update, now)
+				}
 			}
 
 			t.RUnlock()
@@ -53,9 +70,12 @@ func (t *Leader) tickerAggsUpdateLoop(ctx context.Context) error {
 			return ctx.Err()
 		case <-timer1.C:
 			if err := t.refreshTickerAggs(ctx); err != nil {
+				t.health.failed(err)
 				logrus.WithError(err).Error("Unable to update ticker aggs.")
 				// We probably don't want to completely exit if ever 1 API call fails.
 				// return err
+			} else {
+				t.health.aggsRefreshed()
 			}
 		}
 	}
@@ -70,9 +90,12 @@ func (t *Leader) tickerDetailsUpdateLoop(ctx context.Context) error {
 			return ctx.Err()
 		case <-timer1.C:
 			if err := t.refreshTickerDetails(ctx, false); err != nil {
+				t.health.failed(err)
 				logrus.WithError(err).Error("Unable to update ticker details.")
 				// We probably don't want to completely exit if ever 1 API call fails.
 				// return err
+			} else {
+				t.health.detailsRefreshed()
 			}
 		}
 	}
@@ -0,0 +1,89 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// priceSource is a data source which only streams prices.
+type priceSource struct {
+	DataSource
+	prices chan *models.PriceUpdate
+}
+
+func (s *priceSource) PriceUpdates() <-chan *models.PriceUpdate {
+	return s.prices
+}
+
+// Run with -race, prices change the walls tickers while they are being sent to screens.
+func TestPricesDontChangeSentTickers(t *testing.T) {
+	wall := newWall(models.DefaultWall, &models.PresentationSettings{})
+	wall.Tickers = []*models.Ticker{{Ticker: "AAPL"}}
+	source := &priceSource{prices: make(chan *models.PriceUpdate)}
+	leader := &Leader{
+		Walls:      map[string]*Wall{models.DefaultWall: wall},
+		Updates:    make(chan *models.Update, 1000),
+		DataClient: source,
+		health:     newSourceHealth(SourceSim),
+	}
+	client := &UpdateClient{
+		Screen: &models.Screen{UUID: "a", Wall: models.DefaultWall},
+		queue:  newSendQueue(),
+	}
+	if err := leader.addScreenToCluster(client); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = leader.broadcastPriceUpdatesLoop(ctx)
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 500; i++ {
+			source.prices <- &models.PriceUpdate{Ticker: "AAPL", Price: float64(i)}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 500; i++ {
+			leader.updateTicker("AAPL", func(ticker *models.Ticker) bool {
+				ticker.CompanyName = "Apple"
+				return true
+			})
+		}
+	}()
+
+	// Send the tickers like ServeScreen does, without the leaders lock.
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	for sent := false; !sent; {
+		select {
+		case <-done:
+			sent = true
+		case <-client.queue.ready:
+		}
+		for _, update := range drain(client.queue) {
+			if _, err := proto.Marshal(update); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	leader.RLock()
+	defer leader.RUnlock()
+	if ticker := wall.Tickers[0]; ticker.CompanyName != "Apple" {
+		t.Errorf("ticker = %v, want the updated company name", ticker)
+	}
+}
@@ -0,0 +1,50 @@
+package leader
+
+import (
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	modelsv2 "github.com/polygon-io/go-app-ticker-wall/models/v2"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// V2Server serves the v2 Leader service. Only JoinCluster has changed in v2, the other RPCs are
+// served by the v1 Leader service.
+type V2Server struct {
+	modelsv2.UnimplementedLeaderServer
+	leader *Leader
+}
+
+// V2 gets the v2 Leader service, so v1 and v2 screens can be served at the same time.
+func (t *Leader) V2() *V2Server {
+	return &V2Server{leader: t}
+}
+
+// JoinCluster streams the walls updates to a screen with the v2 protocol. The stream starts with a
+// handshake, giving the protocol version the rest of the stream is sent with.
+func (t *V2Server) JoinCluster(screen *models.Screen, stream modelsv2.Leader_JoinClusterServer) error {
+	if screen.ProtocolVersion < models.ProtocolV2 {
+		return status.Errorf(codes.InvalidArgument, "protocol v%d screens join with the v1 JoinCluster", screen.ProtocolVersion)
+	}
+
+	// Newer screens get the newest protocol we have, it's up to them if they can speak it.
+	screen.ProtocolVersion = models.ProtocolV2
+	if err := stream.Send(&modelsv2.Update{
+		Payload: &modelsv2.Update_Handshake{Handshake: &modelsv2.Handshake{
+			ProtocolVersion:    models.ProtocolV2,
+			MinProtocolVersion: models.ProtocolV1,
+			MaxProtocolVersion: models.ProtocolV2,
+		}},
+	}); err != nil {
+		return err
+	}
+
+	return t.leader.ServeScreen(stream.Context(), screen, func(update *models.Update) error {
+		v2Update, err := modelsv2.FromV1(update)
+		if err != nil {
+			logrus.WithError(err).Warn("Unable to send update to v2 screen.")
+			return nil
+		}
+		return stream.Send(v2Update)
+	})
+}
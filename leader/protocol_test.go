@@ -0,0 +1,98 @@
+package leader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	modelsv2 "github.com/polygon-io/go-app-ticker-wall/models/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// v2Stream collects the updates sent to a v2 screen, and ends the stream once it has enough.
+type v2Stream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	cancel  context.CancelFunc
+	want    int
+	updates []*modelsv2.Update
+}
+
+func (s *v2Stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *v2Stream) Send(update *modelsv2.Update) error {
+	s.updates = append(s.updates, update)
+	if len(s.updates) == s.want {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestJoinClusterV2(t *testing.T) {
+	wall := newWall(models.DefaultWall, &models.PresentationSettings{})
+	leader := &Leader{
+		Walls:   map[string]*Wall{models.DefaultWall: wall},
+		Updates: make(chan *models.Update, 10),
+	}
+
+	// v1 screens can't use the v2 stream.
+	err := leader.V2().JoinCluster(&models.Screen{UUID: "a"}, &v2Stream{ctx: context.Background()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("JoinCluster() of a v1 screen = %v, want InvalidArgument", err)
+	}
+
+	// Newer screens are given v2.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &v2Stream{ctx: ctx, cancel: cancel, want: 3}
+	screen := &models.Screen{UUID: "b", ProtocolVersion: 3}
+	if err := leader.V2().JoinCluster(screen, stream); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stream.updates) != 3 {
+		t.Fatalf("sent %d updates, want the handshake, a snapshot and the cluster update", len(stream.updates))
+	}
+	if handshake := stream.updates[0].GetHandshake(); handshake.GetProtocolVersion() != models.ProtocolV2 {
+		t.Errorf("handshake = %v, want protocol v2", handshake)
+	}
+	snapshot := stream.updates[1]
+	if snapshot.GetSnapshot() == nil || snapshot.Wall != models.DefaultWall {
+		t.Errorf("second update = %v, want a snapshot of the default wall", snapshot)
+	}
+	if screen.ProtocolVersion != models.ProtocolV2 {
+		t.Errorf("screen protocol = %d, want 2", screen.ProtocolVersion)
+	}
+}
+
+func TestUpdateV2RoundTrip(t *testing.T) {
+	updates := []*models.Update{
+		{UpdateType: int32(models.UpdateTypeSnapshot), Snapshot: &models.Snapshot{Sequence: 4}},
+		{UpdateType: int32(models.UpdateTypeCluster), ScreenCluster: &models.ScreenCluster{}, Sequence: 5},
+		{UpdateType: int32(models.UpdateTypeTickerAdded), Ticker: &models.Ticker{Ticker: "AAPL"}, Sequence: 6},
+		{UpdateType: int32(models.UpdateTypeTickerRemoved), Ticker: &models.Ticker{Ticker: "AAPL"}, Sequence: 7},
+		{UpdateType: int32(models.UpdateTypeTickerUpdate), Ticker: &models.Ticker{Ticker: "AMD"}, Sequence: 8},
+		{UpdateType: int32(models.UpdateTypePrice), PriceUpdate: &models.PriceUpdate{Ticker: "AMD", Price: 1}},
+		{UpdateType: int32(models.UpdateTypeAnnouncement), Announcement: &models.Announcement{Message: "Hi"}, Sequence: 9},
+		{UpdateType: int32(models.UpdatePresentationSettings), PresentationSettings: &models.PresentationSettings{}, Sequence: 10},
+	}
+
+	for _, update := range updates {
+		update.Wall = "lobby"
+		v2Update, err := modelsv2.FromV1(update)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := v2Update.V1(); !proto.Equal(got, update) {
+			t.Errorf("round trip of %v = %v", update, got)
+		}
+	}
+
+	if _, err := modelsv2.FromV1(&models.Update{}); err == nil {
+		t.Error("FromV1() of an unknown update type should fail")
+	}
+}
@@ -0,0 +1,157 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/polygon-io/go-app-ticker-wall/recording"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	tombv2 "gopkg.in/tomb.v2"
+)
+
+// runReplay runs the leader using a recording as the source of updates.
+func (t *Leader) runReplay(ctx context.Context) error {
+	logrus.WithField("file", t.config.ReplayFile).Info("Replaying recording. Ready for Clients.")
+
+	// Create new tomb for this process.
+	tomb, ctx := tombv2.WithContext(ctx)
+
+	// Broadcast updates to clients.
+	tomb.Go(func() error {
+		return t.clientUpdateLoop(ctx)
+	})
+
+	// Lay out the tickers from the recording as they come in.
+	tomb.Go(func() error {
+		return t.tickerLayoutLoop(ctx)
+	})
+
+	// Feed the recording into our updates.
+	tomb.Go(func() error {
+		t.health.setStreaming(true)
+		defer t.health.setStreaming(false)
+
+		for {
+			if err := t.replayRecording(ctx); err != nil {
+				return err
+			}
+
+			if !t.config.ReplayLoop {
+				logrus.Info("Replay finished.")
+				return nil
+			}
+
+			logrus.Debug("Replay finished, starting over..")
+		}
+	})
+
+	return tomb.Wait()
+}
+
+// replayRecording plays the recording once, keeping the original spacing between updates ( scaled
+// by the replay speed ).
+func (t *Leader) replayRecording(ctx context.Context) error {
+	f, err := os.Open(t.config.ReplayFile)
+	if err != nil {
+		return fmt.Errorf("unable to open recording: %w", err)
+	}
+	defer f.Close()
+
+	speed := t.config.ReplaySpeed
+	if speed <= 0 {
+		speed = 1
+	}
+
+	reader := recording.NewReader(f)
+	startedAt := time.Now()
+	var firstReceivedAt time.Time
+
+	for {
+		receivedAt, update, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if errors.Is(err, recording.ErrBadEntry) {
+			logrus.WithError(err).Warn("Skipping bad line in recording.")
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if firstReceivedAt.IsZero() {
+			firstReceivedAt = receivedAt
+		}
+
+		// Wait until it's time to play this update.
+		playAt := startedAt.Add(time.Duration(float64(receivedAt.Sub(firstReceivedAt)) / speed))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Until(playAt)):
+		}
+
+		t.replayUpdate(ctx, update)
+	}
+}
+
+// hasPayload checks the update has the payload it's update type needs. Recordings can be edited
+// by hand, so they aren't trusted to.
+func hasPayload(update *models.Update) bool {
+	switch models.UpdateType(update.UpdateType) {
+	case models.UpdateTypeTickerAdded, models.UpdateTypeTickerUpdate, models.UpdateTypeTickerRemoved:
+		return update.Ticker != nil
+	case models.UpdateTypePrice:
+		return update.PriceUpdate != nil
+	case models.UpdateTypeAnnouncement:
+		return update.Announcement != nil
+	}
+	return true
+}
+
+// replayUpdate applies a recorded update to our state and sends it to clients. Only ticker,
+// price and announcement updates are replayed, the cluster and settings are always live. Recordings
+// are always replayed onto the default wall. Updates missing their payload are skipped.
+func (t *Leader) replayUpdate(ctx context.Context, update *models.Update) {
+	if !hasPayload(update) {
+		logrus.WithField("update_type", update.UpdateType).Warn("Skipping recorded update without a payload.")
+		return
+	}
+
+	switch models.UpdateType(update.UpdateType) {
+	case models.UpdateTypeTickerAdded, models.UpdateTypeTickerUpdate:
+		t.Lock()
+		wall := t.Walls[models.DefaultWall]
+		// Copied, the update may be in the middle of being sent to clients.
+		wall.Tickers = upsertTicker(wall.Tickers, proto.Clone(update.Ticker).(*models.Ticker))
+		wall.publish(update)
+		t.Unlock()
+
+	case models.UpdateTypeTickerRemoved:
+		t.Lock()
+		wall := t.Walls[models.DefaultWall]
+		wall.Tickers = removeTicker(wall.Tickers, update.Ticker.Ticker)
+		wall.publish(update)
+		t.Unlock()
+
+	case models.UpdateTypePrice:
+		t.health.priceUpdated()
+
+		t.Lock()
+		t.Walls[models.DefaultWall].setPrice(update.PriceUpdate.Ticker, update.PriceUpdate.Price)
+		t.Unlock()
+		update.Wall = models.DefaultWall
+		t.broadcast(update)
+
+	case models.UpdateTypeAnnouncement:
+		// Announce sets a fresh display time, the recorded one is in the past.
+		update.Announcement.Wall = models.DefaultWall
+		if _, err := t.Announce(ctx, update.Announcement); err != nil {
+			logrus.WithError(err).Warn("Unable to replay announcement.")
+		}
+	}
+}
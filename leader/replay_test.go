@@ -0,0 +1,84 @@
+package leader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/polygon-io/go-app-ticker-wall/recording"
+)
+
+func TestReplaySkipsBadUpdates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.jsonl")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := recording.NewWriter(f)
+	for _, update := range []*models.Update{
+		{UpdateType: int32(models.UpdateTypeTickerAdded), Ticker: &models.Ticker{Ticker: "AAPL", Price: 100}},
+		// Updates without their payload.
+		{UpdateType: int32(models.UpdateTypeTickerUpdate)},
+		{UpdateType: int32(models.UpdateTypeTickerRemoved)},
+		{UpdateType: int32(models.UpdateTypePrice)},
+		{UpdateType: int32(models.UpdateTypeAnnouncement)},
+		{UpdateType: int32(models.UpdateTypePrice), PriceUpdate: &models.PriceUpdate{Ticker: "AAPL", Price: 150}},
+	} {
+		if err := w.Write(time.Now(), update); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	// A line which was cut off while it was being written.
+	if _, err := f.WriteString(`{"timestamp_ms":1,"upd`); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	leader, err := New(&Config{
+		ReplayFile:   path,
+		ReplaySpeed:  1000,
+		Presentation: &models.PresentationSettings{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &UpdateClient{
+		Screen: &models.Screen{UUID: "a", Wall: models.DefaultWall},
+		queue:  newSendQueue(),
+	}
+	if err := leader.addScreenToCluster(client); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := leader.replayRecording(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	wall := leader.Walls[models.DefaultWall]
+	if got := tickerSymbols(wall.Tickers); got != "AAPL" {
+		t.Errorf("tickers = %s, want AAPL", got)
+	}
+	if price := wall.Tickers[0].Price; price != 150 {
+		t.Errorf("price = %v, want 150", price)
+	}
+
+	// The ticker sent to the screen keeps the price it was sent with.
+	var added *models.Update
+	for _, update := range drain(client.queue) {
+		if update.UpdateType == int32(models.UpdateTypeTickerAdded) {
+			added = update
+		}
+	}
+	if added == nil {
+		t.Fatal("the added ticker wasn't sent")
+	}
+	if added.Ticker.Price != 100 {
+		t.Errorf("sent ticker price = %v, want 100", added.Ticker.Price)
+	}
+}
@@ -3,54 +3,103 @@ package leader
 import (
 	"errors"
 	"sort"
+	"time"
 
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // UpdateClient is a generic wrapper which is used for all clients which are requesting
 // updates be sent to them.
 type UpdateClient struct {
-	Screen  *models.Screen
-	Updates chan *models.Update
-	Stream  models.Leader_JoinClusterServer
+	Screen *models.Screen
+
+	// Updates waiting to be sent to the screen.
+	queue *sendQueue
+
+	// Owner is the name of who joined the screen, they can update it without the UpdateScreen
+	// permission. Empty when access control isn't enabled, or the screen joined anonymously.
+	Owner string
+
+	// kicked is closed when the screen is kicked from it's wall.
+	kicked chan struct{}
 }
 
-// CurrentScreenCluster will take the current clients and create a ScreenCluster model.
-func (t *Leader) CurrentScreenCluster() *models.ScreenCluster {
+// kick disconnects the screen from it's wall, if it hasn't been already. Must be called while
+// holding the lock.
+func (c *UpdateClient) kick() {
+	select {
+	case <-c.kicked:
+		// Already kicked, it's on it's way out.
+	default:
+		close(c.kicked)
+	}
+}
+
+// CurrentScreenCluster will take the current clients of a wall and create a ScreenCluster model.
+func (t *Leader) CurrentScreenCluster(wallName string) (*models.ScreenCluster, error) {
 	t.RLock()
 	defer t.RUnlock()
 
-	res := &models.ScreenCluster{}
-	res.Settings = t.PresentationSettings
-
-	for _, client := range t.Clients {
-		res.Screens = append(res.Screens, client.Screen)
+	wall, err := t.findWall(wallName)
+	if err != nil {
+		return nil, err
 	}
 
-	return res
+	return wall.screenCluster(), nil
 }
 
-func (t *Leader) addScreenToCluster(screenClient *UpdateClient) {
-	// Add the client and sort them (asc).
+// addScreenToCluster adds the screen to it's wall. The first update queued for the screen is a
+// snapshot of the wall, later updates continue on from it's sequence, starting with the cluster
+// update which adds the screen. Screens can only join walls
+// which exist, and only once, a second screen with the same UUID would be able to update the first.
+func (t *Leader) addScreenToCluster(screenClient *UpdateClient) error {
+	// Add the client to it's wall and sort them (asc).
 	t.Lock()
-	t.Clients = append(t.Clients, screenClient)
-	sort.Sort(UpdateClientSlice(t.Clients))
-	t.Unlock()
+	wall, err := t.findWall(screenClient.Screen.Wall)
+	if err != nil {
+		t.Unlock()
+		return err
+	}
+	for _, client := range wall.Clients {
+		if client.Screen.UUID == screenClient.Screen.UUID {
+			t.Unlock()
+			return status.Errorf(codes.AlreadyExists, "screen %s is already on the %s wall", client.Screen.UUID, wall.Name)
+		}
+	}
+	wall.Clients = append(wall.Clients, screenClient)
+	sort.Sort(UpdateClientSlice(wall.Clients))
+	screenClient.queue.push(&models.Update{
+		UpdateType: int32(models.UpdateTypeSnapshot),
+		Wall:       wall.Name,
+		Snapshot:   wall.snapshot(),
+	}, time.Now())
 
 	// Update the cluster
-	t.Updates <- &models.Update{
+	wall.publish(&models.Update{
 		UpdateType:    int32(models.UpdateTypeCluster),
-		ScreenCluster: t.CurrentScreenCluster(),
-	}
+		ScreenCluster: wall.screenCluster(),
+	})
+	t.Unlock()
+
+	return nil
 }
 
 func (t *Leader) removeScreenFromCluster(screen *UpdateClient) error {
 	t.Lock()
 
+	wall, ok := t.Walls[screen.Screen.Wall]
+	if !ok {
+		// The wall was deleted, along with it's screens.
+		t.Unlock()
+		return nil
+	}
+
 	// Find index of screen.
 	screenIndex := -1
-	for i, sc := range t.Clients {
+	for i, sc := range wall.Clients {
 		if sc.Screen.UUID == screen.Screen.UUID {
 			screenIndex = i
 		}
@@ -63,30 +112,26 @@ func (t *Leader) removeScreenFromCluster(screen *UpdateClient) error {
 	}
 
 	// Remove the element from the slice.
-	t.Clients[screenIndex] = t.Clients[len(t.Clients)-1]
-	t.Clients[len(t.Clients)-1] = nil
-	t.Clients = t.Clients[:len(t.Clients)-1]
+	wall.Clients[screenIndex] = wall.Clients[len(wall.Clients)-1]
+	wall.Clients[len(wall.Clients)-1] = nil
+	wall.Clients = wall.Clients[:len(wall.Clients)-1]
 
-	// Re-sort.
-	sort.Sort(UpdateClientSlice(t.Clients))
+	// Re-sort, and update the cluster.
+	sort.Sort(UpdateClientSlice(wall.Clients))
+	wall.publish(&models.Update{
+		UpdateType:    int32(models.UpdateTypeCluster),
+		ScreenCluster: wall.screenCluster(),
+	})
 
 	t.Unlock()
 
-	// Close the clients updates channel.
-	close(screen.Updates)
-
 	logrus.WithFields(logrus.Fields{
+		"wall":   screen.Screen.Wall,
 		"uuid":   screen.Screen.UUID,
 		"index":  screen.Screen.Index,
 		"width":  screen.Screen.Width,
 		"height": screen.Screen.Height,
 	}).Info("Removed screen to cluster.")
 
-	// Update the cluster
-	t.Updates <- &models.Update{
-		UpdateType:    int32(models.UpdateTypeCluster),
-		ScreenCluster: t.CurrentScreenCluster(),
-	}
-
 	return nil
 }
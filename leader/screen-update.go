@@ -2,44 +2,138 @@ package leader
 
 import (
 	"context"
-	"errors"
+	"sort"
 
+	"github.com/polygon-io/go-app-ticker-wall/auth"
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
-// UpdatePresentationSettings updates the presentation settings of the cluster and sends out an
-// update to all clients.
+// UpdateScreen replaces the settings of a screen, and sends out the new cluster to all clients.
 func (t *Leader) UpdateScreen(ctx context.Context, newScreenSettings *models.Screen) (*models.Screen, error) {
+	return t.PatchScreen(ctx, newScreenSettings, nil)
+}
+
+// PatchScreen updates the fields of a screen listed in the update mask, the rest keep their current
+// value. An empty mask replaces every field, like UpdateScreen. The screen is found by the UUID and
+// wall of changes, observers included.
+func (t *Leader) PatchScreen(ctx context.Context, changes *models.Screen, updateMask *fieldmaskpb.FieldMask) (*models.Screen, error) {
 	logrus.WithFields(logrus.Fields{
-		"UUID":   newScreenSettings.UUID,
-		"width":  newScreenSettings.Width,
-		"height": newScreenSettings.Height,
-		"index":  newScreenSettings.Index,
-	}).Debug("Update presentation settings..")
+		"wall": changes.Wall,
+		"UUID": changes.UUID,
+		"mask": updateMask.GetPaths(),
+	}).Debug("Update screen..")
 
-	didFind := false
 	t.Lock()
-	for _, client := range t.Clients {
+	wall, err := t.findWall(changes.Wall)
+	if err != nil {
+		t.Unlock()
+		return nil, err
+	}
+
+	var oldScreen, newScreen *models.Screen
+	for _, client := range wall.Clients {
 		// Find the screen we want to update
-		if client.Screen.UUID == newScreenSettings.UUID {
-			client.Screen = newScreenSettings
-			didFind = true
+		if client.Screen.UUID == changes.UUID {
+			// Without the permission, screens can only update themselves.
+			if id, ok := auth.FromContext(ctx); ok && !id.Can("UpdateScreen") && !ownsScreen(ctx, id, client) {
+				t.Unlock()
+				return nil, status.Errorf(codes.PermissionDenied, "the %s role can only update it's own screens", id.Role)
+			}
+
+			// Apply the changes to a copy, the current screen may be in the middle of being sent to clients.
+			newScreen = proto.Clone(client.Screen).(*models.Screen)
+			if err := applyFieldMask(newScreen, changes, updateMask.GetPaths()); err != nil {
+				t.Unlock()
+				return nil, status.Errorf(codes.InvalidArgument, "invalid update mask: %v", err)
+			}
+			newScreen.UUID = client.Screen.UUID
+
+			// The clock measurements are reported separately, keep them.
+			newScreen.ClockOffsetNS = client.Screen.ClockOffsetNS
+			newScreen.ClockRoundTripNS = client.Screen.ClockRoundTripNS
+			// The send stats and protocol are the leaders.
+			newScreen.SendStats = nil
+			newScreen.ProtocolVersion = client.Screen.ProtocolVersion
+			// Screens can't move between walls, they need to re-join.
+			newScreen.Wall = client.Screen.Wall
+			oldScreen = client.Screen
+			client.Screen = newScreen
 			break
 		}
 	}
-	t.Unlock()
 
 	// Couldn't find correct screen to update.
-	if !didFind {
-		return nil, errors.New("unable to find screen to update with given UUID")
+	if oldScreen == nil {
+		t.Unlock()
+		return nil, status.Error(codes.NotFound, "unable to find screen to update with given UUID")
 	}
 
-	// Update the cluster
-	t.Updates <- &models.Update{
+	// The index may have changed.
+	sort.Sort(UpdateClientSlice(wall.Clients))
+	wall.publish(&models.Update{
 		UpdateType:    int32(models.UpdateTypeCluster),
-		ScreenCluster: t.CurrentScreenCluster(),
+		ScreenCluster: wall.screenCluster(),
+	})
+	t.Unlock()
+
+	t.audit(ctx, "UpdateScreen", wall.Name, newScreen.UUID, oldScreen, newScreen)
+
+	return newScreen, nil
+}
+
+type ownScreenKey struct{}
+
+// OwnScreenContext marks a context as coming from the connection a screen joined with, so the screen
+// can update itself even when it joined anonymously.
+func OwnScreenContext(ctx context.Context, uuid string) context.Context {
+	return context.WithValue(ctx, ownScreenKey{}, uuid)
+}
+
+// ownsScreen checks if the request is from whoever joined the screen. Anonymous requests all have
+// the same name, so they only own a screen over the connection it joined with.
+func ownsScreen(ctx context.Context, id *auth.Identity, client *UpdateClient) bool {
+	if uuid, ok := ctx.Value(ownScreenKey{}).(string); ok && uuid == client.Screen.UUID {
+		return true
 	}
+	return client.Owner != "" && !id.Anonymous() && client.Owner == id.Name
+}
+
+// KickScreen disconnects a screen from it's wall. The screen is removed once it's connection ends.
+func (t *Leader) KickScreen(ctx context.Context, req *models.Screen) (*models.Screen, error) {
+	t.Lock()
+	wall, err := t.findWall(req.Wall)
+	if err != nil {
+		t.Unlock()
+		return nil, err
+	}
+
+	var kicked *models.Screen
+	for _, client := range wall.Clients {
+		if client.Screen.UUID != req.UUID {
+			continue
+		}
+
+		client.kick()
+		kicked = client.Screen
+		break
+	}
+	t.Unlock()
+
+	if kicked == nil {
+		return nil, status.Error(codes.NotFound, "unable to find screen to kick with given UUID")
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"wall": wall.Name,
+		"uuid": kicked.UUID,
+	}).Info("Kicked screen from cluster.")
+
+	t.audit(ctx, "KickScreen", wall.Name, kicked.UUID, kicked, nil)
 
-	return newScreenSettings, nil
+	return kicked, nil
 }
@@ -0,0 +1,59 @@
+package leader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScreensCanOnlyUpdateThemselves(t *testing.T) {
+	access := &auth.Config{Access: auth.AccessControl{
+		DefaultRole: auth.RoleViewer,
+		Users:       []auth.User{{Name: "lobby", Token: "lobby-token", Role: auth.RoleViewer}},
+	}}
+	anonymous, err := access.Authenticate("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lobby, err := access.Authenticate("Bearer lobby-token", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wall := newWall(models.DefaultWall, &models.PresentationSettings{})
+	leader := &Leader{
+		Walls:   map[string]*Wall{models.DefaultWall: wall},
+		Updates: make(chan *models.Update, 10),
+		audits:  &auditLog{},
+	}
+	// Every anonymous request has the same name, so it's never a match even as the owner.
+	wall.Clients = []*UpdateClient{
+		{Screen: &models.Screen{UUID: "anonymous", Wall: models.DefaultWall}, queue: newSendQueue(), Owner: anonymous.Name},
+		{Screen: &models.Screen{UUID: "lobby", Wall: models.DefaultWall}, queue: newSendQueue(), Owner: lobby.Name},
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		uuid string
+		want codes.Code
+	}{
+		{"owner", auth.NewContext(context.Background(), lobby), "lobby", codes.OK},
+		{"someone elses screen", auth.NewContext(context.Background(), lobby), "anonymous", codes.PermissionDenied},
+		{"anonymous", auth.NewContext(context.Background(), anonymous), "anonymous", codes.PermissionDenied},
+		{"anonymous updating a named screen", auth.NewContext(context.Background(), anonymous), "lobby", codes.PermissionDenied},
+		{"the screens own connection", OwnScreenContext(auth.NewContext(context.Background(), anonymous), "anonymous"), "anonymous", codes.OK},
+		{"another screens connection", OwnScreenContext(auth.NewContext(context.Background(), anonymous), "anonymous"), "lobby", codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		_, err := leader.UpdateScreen(tt.ctx, &models.Screen{UUID: tt.uuid, Wall: models.DefaultWall, Width: 1920})
+		if status.Code(err) != tt.want {
+			t.Errorf("%s: UpdateScreen() = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
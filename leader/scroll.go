@@ -0,0 +1,85 @@
+package leader
+
+import (
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// scrollPosition gets where the tape currently is, wrapped to the width of the tape. Must be
+// called while holding the lock.
+func (w *Wall) scrollPosition(now time.Time) float64 {
+	settings := w.PresentationSettings
+	return models.WrapOffset(settings.ScrollOffset(now), settings.TapeWidth(len(w.Tickers)))
+}
+
+// scrollNow is the current time, truncated to the precision of a scroll epoch.
+func scrollNow() time.Time {
+	return time.UnixMilli(time.Now().UnixMilli())
+}
+
+// reanchorScroll starts a new scroll epoch at the given position, so the tape continues from there
+// using the new settings. The velocity the tape had under the old settings is eased out of, when
+// easing is enabled.
+func reanchorScroll(oldSettings, newSettings *models.PresentationSettings, now time.Time, position float64) {
+	epoch := &models.ScrollEpoch{
+		TimestampMS: now.UnixMilli(),
+		Offset:      position,
+	}
+
+	if newSettings.ScrollEaseDurationMS > 0 {
+		epoch.StartVelocity = oldSettings.ScrollVelocity(now)
+		epoch.EaseDurationMS = newSettings.ScrollEaseDurationMS
+	}
+
+	newSettings.ScrollEpoch = epoch
+}
+
+// applySettings swaps in new settings for the wall, continuing the scroll from the same spot on
+// the tape. When the ticker layout changes, the tape continues from the same place in the same
+// ticker's box. Must be called while holding the lock, before the ticker list is changed.
+func (w *Wall) applySettings(newSettings *models.PresentationSettings, now time.Time) {
+	oldSettings := w.PresentationSettings
+	position := translatePosition(oldSettings.TickerLayout, newSettings.TickerLayout, w.scrollPosition(now))
+
+	reanchorScroll(oldSettings, newSettings, now, position)
+	w.PresentationSettings = newSettings
+}
+
+// translatePosition moves a position on the old layout's tape to the same place on the new
+// layout's tape. If the box it was in has been removed, it continues from the start of the next
+// box which is still on the tape. Positions in the spacer stay the same distance into the spacer.
+func translatePosition(oldLayout, newLayout *models.TickerLayout, position float64) float64 {
+	if oldLayout == nil || newLayout == nil {
+		return position
+	}
+
+	boxes := oldLayout.GetBoxes()
+	for i, box := range boxes {
+		if position >= float64(box.Offset+box.Width) {
+			continue
+		}
+
+		// Keep the same spot within the same box, scaled to it's new width.
+		if newBox := newLayout.Box(box.Ticker); newBox != nil {
+			fraction := 0.0
+			if box.Width > 0 {
+				fraction = (position - float64(box.Offset)) / float64(box.Width)
+			}
+			return float64(newBox.Offset) + (fraction * float64(newBox.Width))
+		}
+
+		// The box was removed, continue from the next one that wasn't.
+		for _, next := range boxes[i+1:] {
+			if newBox := newLayout.Box(next.Ticker); newBox != nil {
+				return float64(newBox.Offset)
+			}
+		}
+
+		// There are no boxes after it, continue from the spacer.
+		return float64(newLayout.TapeWidth)
+	}
+
+	// We're past the tickers, in the spacer.
+	return float64(newLayout.TapeWidth) + (position - float64(oldLayout.TapeWidth))
+}
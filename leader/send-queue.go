@@ -0,0 +1,233 @@
+package leader
+
+import (
+	"sync"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+const (
+	// maxQueuedPrices is how many price updates, of different tickers, can wait to be sent to a
+	// screen. The oldest are dropped after that, the newer prices replace them anyway.
+	maxQueuedPrices = 500
+
+	// maxQueuedUpdates is how many other updates can wait to be sent to a screen. They can't be
+	// dropped, so the screen is disconnected instead.
+	maxQueuedUpdates = 1000
+
+	// maxBehind is how long an update can wait to be sent to a screen before it's disconnected.
+	maxBehind = 30 * time.Second
+	// behindCheckInterval is how often screens are checked for falling behind, when nothing is
+	// being pushed to them.
+	behindCheckInterval = maxBehind / 2
+)
+
+// queuedUpdate is an update waiting to be sent.
+type queuedUpdate struct {
+	update *models.Update
+	seq    uint64
+
+	// queuedAt is when the update was queued. Conflated prices keep the time of the price they
+	// replaced, the ticker has been waiting since then.
+	queuedAt time.Time
+}
+
+// sendQueue holds the updates waiting to be sent to a screen, so a slow screen never holds up the
+// others. Adding to it never blocks. Price updates are conflated per ticker, so only the newest
+// price of a ticker is sent, and the oldest are dropped when too many are waiting. Other updates
+// are never dropped: screens which fall too far behind are disconnected instead.
+type sendQueue struct {
+	sync.Mutex
+
+	items []queuedUpdate
+	seq   uint64
+
+	// latestPrice is the sequence of the newest queued price update of each ticker. Older price
+	// updates of the ticker are skipped when they come up.
+	latestPrice map[string]uint64
+	// priceQueuedAt is when each ticker's queued price started waiting.
+	priceQueuedAt map[string]time.Time
+
+	// Number of queued updates which will be sent, by kind.
+	prices, others int
+
+	// ready is signalled when updates are added.
+	ready chan struct{}
+
+	// behind is closed when the screen has fallen too far behind, and should be disconnected.
+	behind   chan struct{}
+	isBehind bool
+
+	sent, conflated, dropped int64
+}
+
+func newSendQueue() *sendQueue {
+	return &sendQueue{
+		latestPrice:   map[string]uint64{},
+		priceQueuedAt: map[string]time.Time{},
+		ready:         make(chan struct{}, 1),
+		behind:        make(chan struct{}),
+	}
+}
+
+// push adds an update to the queue.
+func (q *sendQueue) push(update *models.Update, now time.Time) {
+	q.Lock()
+	defer q.Unlock()
+
+	// The screen is being disconnected.
+	if q.isBehind {
+		return
+	}
+
+	q.seq++
+	queuedAt := now
+	if update.UpdateType == int32(models.UpdateTypePrice) && update.PriceUpdate != nil {
+		ticker := update.PriceUpdate.Ticker
+		if _, ok := q.latestPrice[ticker]; ok {
+			q.conflated++
+			queuedAt = q.priceQueuedAt[ticker]
+		} else {
+			q.prices++
+			q.priceQueuedAt[ticker] = now
+		}
+		q.latestPrice[ticker] = q.seq
+
+		if q.prices > maxQueuedPrices {
+			q.dropOldestPrice()
+		}
+	} else {
+		q.others++
+	}
+	q.items = append(q.items, queuedUpdate{update: update, seq: q.seq, queuedAt: queuedAt})
+
+	// Skipped price updates stay in the queue until they come up, clean them up once they are most
+	// of it.
+	if len(q.items) > 2*(q.prices+q.others)+64 {
+		q.compact()
+	}
+
+	if q.markBehind(now) {
+		return
+	}
+
+	// Let the sender know, unless it already knows.
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// checkBehind disconnects the screen if it has fallen too far behind. Pushing an update already
+// checks this, this is for screens which stop reading when nothing is being pushed.
+func (q *sendQueue) checkBehind(now time.Time) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.markBehind(now)
+}
+
+// markBehind closes behind if too many updates are waiting, or the next one has waited too long.
+// Returns if the screen is behind. Must be called while holding the lock.
+func (q *sendQueue) markBehind(now time.Time) bool {
+	if !q.isBehind && (q.others > maxQueuedUpdates || q.oldestWait(now) > maxBehind) {
+		q.isBehind = true
+		close(q.behind)
+	}
+	return q.isBehind
+}
+
+// pop takes the next update to send off the queue. False is returned when it's empty, or the
+// screen has fallen behind.
+func (q *sendQueue) pop() (*models.Update, bool) {
+	q.Lock()
+	defer q.Unlock()
+
+	for len(q.items) > 0 && !q.isBehind {
+		item := q.items[0]
+		q.items[0] = queuedUpdate{} // Don't keep the update around.
+		q.items = q.items[1:]
+
+		if !q.isLive(item) {
+			continue
+		}
+
+		if item.update.UpdateType == int32(models.UpdateTypePrice) && item.update.PriceUpdate != nil {
+			delete(q.latestPrice, item.update.PriceUpdate.Ticker)
+			delete(q.priceQueuedAt, item.update.PriceUpdate.Ticker)
+			q.prices--
+		} else {
+			q.others--
+		}
+		q.sent++
+
+		return item.update, true
+	}
+
+	return nil, false
+}
+
+// oldestWait is how long the next update to be sent has been waiting. While the screen isn't
+// sending anything it stays at the front of the queue, conflated prices keep their original time,
+// so this keeps growing. Skipped price updates at the front of the queue are removed first, they
+// won't be sent. Must be called while holding the lock.
+func (q *sendQueue) oldestWait(now time.Time) time.Duration {
+	for len(q.items) > 0 && !q.isLive(q.items[0]) {
+		q.items[0] = queuedUpdate{} // Don't keep the update around.
+		q.items = q.items[1:]
+	}
+	if len(q.items) == 0 {
+		return 0
+	}
+	return now.Sub(q.items[0].queuedAt)
+}
+
+// isLive is false for price updates which were conflated or dropped. Must be called while holding
+// the lock.
+func (q *sendQueue) isLive(item queuedUpdate) bool {
+	if item.update.UpdateType != int32(models.UpdateTypePrice) || item.update.PriceUpdate == nil {
+		return true
+	}
+	seq, ok := q.latestPrice[item.update.PriceUpdate.Ticker]
+	return ok && seq == item.seq
+}
+
+// dropOldestPrice drops the oldest queued price update. Must be called while holding the lock.
+func (q *sendQueue) dropOldestPrice() {
+	for _, item := range q.items {
+		if item.update.UpdateType != int32(models.UpdateTypePrice) || !q.isLive(item) {
+			continue
+		}
+
+		delete(q.latestPrice, item.update.PriceUpdate.Ticker)
+		delete(q.priceQueuedAt, item.update.PriceUpdate.Ticker)
+		q.prices--
+		q.dropped++
+		return
+	}
+}
+
+// compact removes skipped price updates from the queue. Must be called while holding the lock.
+func (q *sendQueue) compact() {
+	live := make([]queuedUpdate, 0, q.prices+q.others)
+	for _, item := range q.items {
+		if q.isLive(item) {
+			live = append(live, item)
+		}
+	}
+	q.items = live
+}
+
+// stats returns the queues counters.
+func (q *sendQueue) stats() *models.SendStats {
+	q.Lock()
+	defer q.Unlock()
+
+	return &models.SendStats{
+		Queued:    int64(q.prices + q.others),
+		Sent:      q.sent,
+		Conflated: q.conflated,
+		Dropped:   q.dropped,
+	}
+}
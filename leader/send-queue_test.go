@@ -0,0 +1,199 @@
+package leader
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+func priceUpdate(ticker string, price float64) *models.Update {
+	return &models.Update{
+		UpdateType:  int32(models.UpdateTypePrice),
+		PriceUpdate: &models.PriceUpdate{Ticker: ticker, Price: price},
+	}
+}
+
+func clusterUpdate() *models.Update {
+	return &models.Update{
+		UpdateType:    int32(models.UpdateTypeCluster),
+		ScreenCluster: &models.ScreenCluster{},
+	}
+}
+
+// drain pops everything off the queue.
+func drain(q *sendQueue) []*models.Update {
+	var updates []*models.Update
+	for {
+		update, ok := q.pop()
+		if !ok {
+			return updates
+		}
+		updates = append(updates, update)
+	}
+}
+
+// behind checks if the queue has fallen behind.
+func behind(q *sendQueue) bool {
+	select {
+	case <-q.behind:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestSendQueueConflatesPrices(t *testing.T) {
+	q := newSendQueue()
+	now := time.Now()
+
+	q.push(priceUpdate("AAPL", 1), now)
+	q.push(priceUpdate("AMD", 2), now)
+	q.push(clusterUpdate(), now)
+	q.push(priceUpdate("AAPL", 3), now)
+
+	updates := drain(q)
+	if len(updates) != 3 {
+		t.Fatalf("sent %d updates, want 3", len(updates))
+	}
+
+	// The first AAPL price is replaced by the newer one, which stays after the cluster update.
+	if got := updates[0].PriceUpdate; got.Ticker != "AMD" {
+		t.Errorf("first update = %v, want AMD", got)
+	}
+	if updates[1].UpdateType != int32(models.UpdateTypeCluster) {
+		t.Errorf("second update = %v, want the cluster update", updates[1])
+	}
+	if got := updates[2].PriceUpdate; got.Ticker != "AAPL" || got.Price != 3 {
+		t.Errorf("third update = %v, want AAPL at 3", got)
+	}
+
+	stats := q.stats()
+	if stats.Sent != 3 || stats.Conflated != 1 || stats.Dropped != 0 || stats.Queued != 0 {
+		t.Errorf("stats = %v, want 3 sent and 1 conflated", stats)
+	}
+}
+
+func TestSendQueueDropsOldestPrices(t *testing.T) {
+	q := newSendQueue()
+	now := time.Now()
+
+	q.push(clusterUpdate(), now)
+	for i := 0; i < maxQueuedPrices+10; i++ {
+		q.push(priceUpdate(fmt.Sprintf("T%d", i), float64(i)), now)
+	}
+
+	updates := drain(q)
+	if len(updates) != maxQueuedPrices+1 {
+		t.Fatalf("sent %d updates, want %d", len(updates), maxQueuedPrices+1)
+	}
+
+	// Cluster updates are never dropped, the oldest prices are.
+	if updates[0].UpdateType != int32(models.UpdateTypeCluster) {
+		t.Errorf("first update = %v, want the cluster update", updates[0])
+	}
+	if got := updates[1].PriceUpdate.Ticker; got != "T10" {
+		t.Errorf("oldest price sent = %s, want T10", got)
+	}
+	if stats := q.stats(); stats.Dropped != 10 {
+		t.Errorf("dropped %d price updates, want 10", stats.Dropped)
+	}
+}
+
+func TestSendQueueBehind(t *testing.T) {
+	// Too many updates which can't be dropped.
+	q := newSendQueue()
+	now := time.Now()
+	for i := 0; i < maxQueuedUpdates; i++ {
+		q.push(clusterUpdate(), now)
+	}
+	if behind(q) {
+		t.Fatal("queue is behind before it's full")
+	}
+	q.push(clusterUpdate(), now)
+	if !behind(q) {
+		t.Fatal("queue isn't behind once it's full")
+	}
+	if _, ok := q.pop(); ok {
+		t.Error("nothing should be sent to a screen which is behind")
+	}
+
+	// Not emptied for too long.
+	q = newSendQueue()
+	q.push(priceUpdate("AAPL", 1), now)
+	q.push(priceUpdate("AAPL", 2), now.Add(maxBehind/2))
+	if behind(q) {
+		t.Fatal("queue is behind too early")
+	}
+	q.push(priceUpdate("AAPL", 3), now.Add(maxBehind+time.Second))
+	if !behind(q) {
+		t.Fatal("queue isn't behind after not being emptied")
+	}
+
+	// A screen which is sending, but never empties it's queue, isn't behind while it's updates are
+	// young.
+	q = newSendQueue()
+	q.push(clusterUpdate(), now)
+	for i := 1; i < 10; i++ {
+		q.push(clusterUpdate(), now.Add(time.Duration(i)*maxBehind/2))
+		q.pop()
+	}
+	if behind(q) {
+		t.Error("queue with young updates is behind")
+	}
+
+	// A screen which keeps up is never behind.
+	q = newSendQueue()
+	for i := 0; i < 10; i++ {
+		q.push(priceUpdate("AAPL", float64(i)), now.Add(time.Duration(i)*maxBehind))
+		drain(q)
+	}
+	if behind(q) {
+		t.Error("queue which is emptied is behind")
+	}
+}
+
+func TestSendQueueBehindWithoutPushes(t *testing.T) {
+	now := time.Now()
+
+	// Nothing is pushed after the screen stops reading.
+	q := newSendQueue()
+	q.push(clusterUpdate(), now)
+	q.checkBehind(now.Add(maxBehind / 2))
+	if behind(q) {
+		t.Fatal("queue is behind too early")
+	}
+	q.checkBehind(now.Add(maxBehind + time.Second))
+	if !behind(q) {
+		t.Fatal("queue isn't behind after the screen stopped reading")
+	}
+
+	// Checking again doesn't close behind twice.
+	q.checkBehind(now.Add(2 * maxBehind))
+
+	// An empty queue is never behind.
+	q = newSendQueue()
+	q.push(clusterUpdate(), now)
+	drain(q)
+	q.checkBehind(now.Add(2 * maxBehind))
+	if behind(q) {
+		t.Error("empty queue is behind")
+	}
+}
+
+func TestSendQueueCompacts(t *testing.T) {
+	q := newSendQueue()
+	now := time.Now()
+	for i := 0; i < 10000; i++ {
+		q.push(priceUpdate("AAPL", float64(i)), now)
+	}
+
+	if len(q.items) > 100 {
+		t.Errorf("queue holds %d items for one ticker, conflated updates should be cleaned up", len(q.items))
+	}
+	updates := drain(q)
+	if len(updates) != 1 || updates[0].PriceUpdate.Price != 9999 {
+		t.Errorf("sent %v, want the last price", updates)
+	}
+}
@@ -0,0 +1,156 @@
+package leader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// stateSaveInterval is how often we check if the state has changed and needs to be saved.
+const stateSaveInterval = time.Second
+
+// restoreState loads the persisted state from disk, if there is any. The persisted state takes
+// priority over the config, since it contains the changes made while the leader was running.
+func (t *Leader) restoreState() error {
+	stateBytes, err := os.ReadFile(t.config.StateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		logrus.WithField("file", t.config.StateFile).Info("No saved state found, using defaults.")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to read state file: %w", err)
+	}
+
+	state := &models.LeaderState{}
+	if err := protojson.Unmarshal(stateBytes, state); err != nil {
+		return fmt.Errorf("unable to parse state file: %w", err)
+	}
+
+	// State saved before there were multiple walls only has the default wall.
+	walls := state.Walls
+	if len(walls) == 0 {
+		walls = append(walls, &models.WallState{
+			Name:                 models.DefaultWall,
+			PresentationSettings: state.PresentationSettings,
+			Tickers:              state.Tickers,
+			Announcements:        state.Announcements,
+		})
+	}
+
+	for _, wallState := range walls {
+		t.restoreWall(wallState)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"file":  t.config.StateFile,
+		"walls": len(t.Walls),
+	}).Info("Restored saved state.")
+
+	return nil
+}
+
+// restoreWall restores a walls persisted state. Anything missing from the state uses the defaults
+// from the config.
+func (t *Leader) restoreWall(state *models.WallState) {
+	defaultWall := t.Walls[models.DefaultWall]
+
+	wall := t.createWall(state.Name)
+	if state.PresentationSettings != nil {
+		wall.PresentationSettings = state.PresentationSettings
+	}
+
+	if len(state.Tickers) > 0 {
+		wall.Tickers = nil
+		for _, ticker := range state.Tickers {
+			wall.Tickers = append(wall.Tickers, &models.Ticker{
+				Ticker: ticker,
+			})
+		}
+	} else if wall != defaultWall {
+		// New walls start with the defaults tickers, which may have been restored already.
+		wall.Tickers = nil
+		for _, ticker := range defaultWall.Tickers {
+			wall.Tickers = append(wall.Tickers, &models.Ticker{
+				Ticker: ticker.Ticker,
+			})
+		}
+	}
+
+	wall.Announcements = state.Announcements
+	wall.pruneAnnouncements()
+
+	logrus.WithFields(logrus.Fields{
+		"wall":          wall.Name,
+		"tickers":       len(wall.Tickers),
+		"announcements": len(wall.Announcements),
+	}).Debug("Restored wall.")
+}
+
+// stateSaveLoop saves the state whenever it changes, and once more when we are shutting down.
+func (t *Leader) stateSaveLoop(ctx context.Context) error {
+	timer1 := time.NewTicker(stateSaveInterval)
+	defer timer1.Stop()
+
+	var lastSaved []byte
+	for {
+		select {
+		case <-ctx.Done():
+			if _, err := t.saveState(lastSaved); err != nil {
+				logrus.WithError(err).Error("Unable to save state.")
+			}
+			return ctx.Err()
+		case <-timer1.C:
+			saved, err := t.saveState(lastSaved)
+			if err != nil {
+				logrus.WithError(err).Error("Unable to save state.")
+				continue
+			}
+			lastSaved = saved
+		}
+	}
+}
+
+// saveState writes the current state to disk, unless it's the same as what was last saved. It
+// returns the saved state.
+func (t *Leader) saveState(lastSaved []byte) ([]byte, error) {
+	t.Lock()
+	state := &models.LeaderState{}
+	for _, name := range t.wallNames() {
+		wall := t.Walls[name]
+		wall.pruneAnnouncements()
+		wallState := wall.state()
+		state.Walls = append(state.Walls, wallState)
+	}
+	stateBytes, err := protojson.MarshalOptions{Multiline: true}.Marshal(state)
+	t.Unlock()
+
+	if err != nil {
+		return lastSaved, fmt.Errorf("unable to marshal state: %w", err)
+	}
+
+	// Nothing has changed.
+	if bytes.Equal(stateBytes, lastSaved) {
+		return lastSaved, nil
+	}
+
+	// Write to a temp file and move it into place, so a crash mid write can't corrupt the state.
+	tmpFile := filepath.Join(filepath.Dir(t.config.StateFile), "."+filepath.Base(t.config.StateFile)+".tmp")
+	if err := os.WriteFile(tmpFile, stateBytes, 0o600); err != nil {
+		return lastSaved, fmt.Errorf("unable to write state file: %w", err)
+	}
+
+	if err := os.Rename(tmpFile, t.config.StateFile); err != nil {
+		return lastSaved, fmt.Errorf("unable to write state file: %w", err)
+	}
+
+	logrus.WithField("file", t.config.StateFile).Debug("Saved state.")
+
+	return stateBytes, nil
+}
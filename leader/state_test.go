@@ -0,0 +1,125 @@
+package leader
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func tickerSymbols(tickers []*models.Ticker) string {
+	var symbols []string
+	for _, ticker := range tickers {
+		symbols = append(symbols, ticker.Ticker)
+	}
+	return strings.Join(symbols, ",")
+}
+
+func TestStateRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	cfg := &Config{
+		TickerList:   "AAPL,AMD",
+		WallList:     "lobby",
+		Source:       SourceSim,
+		StateFile:    filepath.Join(t.TempDir(), "state.json"),
+		Presentation: &models.PresentationSettings{ScrollSpeed: 5},
+	}
+	leader, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Change the lobby wall.
+	if _, err := leader.AddTicker(ctx, &models.TickerRequest{Wall: "lobby", Ticker: "TSLA"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = leader.UpdatePresentationSettings(ctx, &models.UpdatePresentationSettingsRequest{
+		Wall:                 "lobby",
+		PresentationSettings: &models.PresentationSettings{ScrollSpeed: 2},
+		UpdateMask:           &fieldmaskpb.FieldMask{Paths: []string{"ScrollSpeed"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = leader.Announce(ctx, &models.Announcement{
+		Wall:              "lobby",
+		Message:           "Later",
+		ShowAtTimestampMS: time.Now().Add(time.Hour).UnixMilli(),
+		LifespanMS:        1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := leader.saveState(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, err := leader.saveState(saved); err != nil || string(again) != string(saved) {
+		t.Errorf("saveState() without changes = %v, want the same state", err)
+	}
+
+	// The saved state takes priority over the config.
+	cfg.TickerList = "NVDA"
+	cfg.WallList = "floor"
+	restored, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walls, _ := restored.ListWalls(ctx, &models.Empty{})
+	if strings.Join(walls.Walls, ",") != "default,floor,lobby" {
+		t.Errorf("walls = %v, want the saved walls and the configured one", walls.Walls)
+	}
+	lobby := restored.Walls["lobby"]
+	if got := tickerSymbols(lobby.Tickers); got != "AAPL,AMD,TSLA" {
+		t.Errorf("lobby tickers = %s, want AAPL,AMD,TSLA", got)
+	}
+	if lobby.PresentationSettings.ScrollSpeed != 2 {
+		t.Errorf("lobby scroll speed = %d, want 2", lobby.PresentationSettings.ScrollSpeed)
+	}
+	if len(lobby.Announcements) != 1 || lobby.Announcements[0].Message != "Later" {
+		t.Errorf("lobby announcements = %v, want the scheduled one", lobby.Announcements)
+	}
+	if got := tickerSymbols(restored.Walls[models.DefaultWall].Tickers); got != "AAPL,AMD" {
+		t.Errorf("default tickers = %s, want the saved AAPL,AMD", got)
+	}
+	if got := tickerSymbols(restored.Walls["floor"].Tickers); got != "AAPL,AMD" {
+		t.Errorf("floor tickers = %s, want a copy of the default wall", got)
+	}
+}
+
+func TestRestoreStateFromBeforeWalls(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	data, err := protojson.Marshal(&models.LeaderState{
+		PresentationSettings: &models.PresentationSettings{ScrollSpeed: 3},
+		Tickers:              []string{"SBUX"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(stateFile, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	leader, err := New(&Config{
+		TickerList:   "AAPL",
+		Source:       SourceSim,
+		StateFile:    stateFile,
+		Presentation: &models.PresentationSettings{ScrollSpeed: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wall := leader.Walls[models.DefaultWall]
+	if got := tickerSymbols(wall.Tickers); got != "SBUX" || wall.PresentationSettings.ScrollSpeed != 3 {
+		t.Errorf("default wall = %s at %d, want the saved SBUX at 3", got, wall.PresentationSettings.ScrollSpeed)
+	}
+}
@@ -0,0 +1,184 @@
+package leader
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/layout"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// tickerLayoutInterval is how often we check if ticker boxes need to be resized.
+	tickerLayoutInterval = time.Second
+
+	// layoutChangeMargin is how far away ( in scrolling time ) a box needs to be from the screens
+	// before it can be resized without anyone seeing the tape move.
+	layoutChangeMargin = 2 * time.Second
+)
+
+// tickerWidth gets the width a tickers box should be with the given settings.
+func (t *Leader) tickerWidth(settings *models.PresentationSettings, ticker *models.Ticker) int32 {
+	if !settings.DynamicTickerWidths {
+		return settings.TickerBoxWidth
+	}
+	return layout.BoxWidth(t.measurer, ticker, settings.TickerBoxWidth)
+}
+
+// keepTickerWidths sizes tickers the same as they are in the current layout, only measuring
+// tickers which are new.
+func (t *Leader) keepTickerWidths(settings *models.PresentationSettings) func(ticker *models.Ticker) int32 {
+	return func(ticker *models.Ticker) int32 {
+		if box := settings.TickerLayout.Box(ticker.Ticker); box != nil {
+			return box.Width
+		}
+		return t.tickerWidth(settings, ticker)
+	}
+}
+
+// layoutTickers positions the tickers on the tape in the order screens display them.
+func layoutTickers(tickers []*models.Ticker, width func(ticker *models.Ticker) int32) *models.TickerLayout {
+	sorted := make(models.TickerSlice, len(tickers))
+	copy(sorted, tickers)
+	sort.Sort(sorted)
+
+	res := &models.TickerLayout{}
+	for _, ticker := range sorted {
+		box := &models.TickerBox{
+			Ticker: ticker.Ticker,
+			Offset: res.TapeWidth,
+			Width:  width(ticker),
+		}
+		res.Boxes = append(res.Boxes, box)
+		res.TapeWidth += box.Width
+	}
+
+	return res
+}
+
+// relayoutWall lays out the given tickers, which are about to become the walls tickers, keeping
+// the width of boxes already on the tape. Must be called while holding the lock, before the ticker
+// list is changed. Returns the new settings.
+func (t *Leader) relayoutWall(wall *Wall, tickers []*models.Ticker, now time.Time) *models.PresentationSettings {
+	// Copy the settings, the current settings may be in the middle of being sent to clients.
+	newSettings := proto.Clone(wall.PresentationSettings).(*models.PresentationSettings)
+	newSettings.TickerLayout = layoutTickers(tickers, t.keepTickerWidths(wall.PresentationSettings))
+	wall.applySettings(newSettings, now)
+
+	return newSettings
+}
+
+// layoutMatchesTickers checks if the layout has a box for exactly the given tickers.
+func layoutMatchesTickers(tickerLayout *models.TickerLayout, tickers []*models.Ticker) bool {
+	if tickerLayout == nil || len(tickerLayout.Boxes) != len(tickers) {
+		return false
+	}
+
+	for _, ticker := range tickers {
+		if tickerLayout.Box(ticker.Ticker) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// tickerLayoutLoop keeps the ticker layout of every wall up to date.
+func (t *Leader) tickerLayoutLoop(ctx context.Context) error {
+	timer1 := time.NewTicker(tickerLayoutInterval)
+	defer timer1.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer1.C:
+			t.refreshTickerLayouts()
+		}
+	}
+}
+
+// refreshTickerLayouts updates the ticker layout of every wall, and sends out the new settings of
+// the walls which have changed.
+func (t *Leader) refreshTickerLayouts() {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, wall := range t.Walls {
+		if newSettings := t.refreshWallLayout(wall, scrollNow()); newSettings != nil {
+			wall.publish(&models.Update{
+				UpdateType:           int32(models.UpdatePresentationSettings),
+				PresentationSettings: newSettings,
+			})
+		}
+	}
+}
+
+// refreshWallLayout lays the tickers out again if the walls tickers have changed, and resizes
+// ticker boxes whose content no longer fits. Boxes are only resized while nobody can see them,
+// unless the tape is too short to ever hide them. Must be called while holding the lock. Returns
+// the new settings, or nil if nothing changed.
+func (t *Leader) refreshWallLayout(wall *Wall, now time.Time) *models.PresentationSettings {
+	settings := wall.PresentationSettings
+
+	// The tickers have changed, lay them out again.
+	if !layoutMatchesTickers(settings.TickerLayout, wall.Tickers) {
+		return t.relayoutWall(wall, wall.Tickers, now)
+	}
+
+	if !settings.DynamicTickerWidths {
+		return nil
+	}
+
+	// Where the screens are currently looking, plus a margin for the tape moving while the
+	// new layout is sent out.
+	margin := math.Max(settings.ScrollVelocity(now), settings.ScrollVelocity(now.Add(layoutChangeMargin))) * float64(layoutChangeMargin.Milliseconds())
+	visibleStart := wall.scrollPosition(now) - margin
+	visibleWidth := float64(wall.screenCluster().GlobalViewportSize()) + (2 * margin)
+	tapeWidth := settings.TapeWidth(len(wall.Tickers))
+
+	widths := make(map[string]int32)
+	for _, ticker := range wall.Tickers {
+		box := settings.TickerLayout.Box(ticker.Ticker)
+		width := t.tickerWidth(settings, ticker)
+		if width == box.Width {
+			continue
+		}
+
+		// The tape repeats across the wall, so a box is only hidden when no repetition of it is visible.
+		hidden := false
+		if visibleWidth < tapeWidth {
+			start := models.WrapOffset(float64(box.Offset)-visibleStart, tapeWidth)
+			hidden = start >= visibleWidth && start+float64(box.Width) <= tapeWidth
+		}
+
+		// When the tape is too short to hide anything, we grow boxes anyways so the content fits.
+		if hidden || (visibleWidth >= tapeWidth && width > box.Width) {
+			widths[ticker.Ticker] = width
+		}
+	}
+
+	if len(widths) == 0 {
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"wall":    wall.Name,
+		"resized": len(widths),
+	}).Debug("Resizing ticker boxes.")
+
+	newSettings := proto.Clone(settings).(*models.PresentationSettings)
+	keepWidths := t.keepTickerWidths(settings)
+	newSettings.TickerLayout = layoutTickers(wall.Tickers, func(ticker *models.Ticker) int32 {
+		if width, ok := widths[ticker.Ticker]; ok {
+			return width
+		}
+		return keepWidths(ticker)
+	})
+	wall.applySettings(newSettings, now)
+
+	return newSettings
+}
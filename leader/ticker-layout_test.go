@@ -0,0 +1,195 @@
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/layout"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// charMeasurer measures every character as 10 pixels wide, whatever the font.
+type charMeasurer struct{}
+
+func (charMeasurer) TextWidth(face string, size float32, text string) float32 {
+	return float32(len(text)) * 10
+}
+
+// checkLayout makes sure every ticker has a box, and the boxes are laid end to end in ticker order.
+func checkLayout(t *testing.T, tickerLayout *models.TickerLayout, symbols ...string) {
+	t.Helper()
+
+	if len(tickerLayout.Boxes) != len(symbols) {
+		t.Fatalf("layout has %d boxes, want %d", len(tickerLayout.Boxes), len(symbols))
+	}
+
+	var offset int32
+	for i, box := range tickerLayout.Boxes {
+		if box.Ticker != symbols[i] {
+			t.Errorf("box %d is %s, want %s", i, box.Ticker, symbols[i])
+		}
+		if box.Offset != offset {
+			t.Errorf("%s box offset = %d, want %d", box.Ticker, box.Offset, offset)
+		}
+		offset += box.Width
+	}
+	if tickerLayout.TapeWidth != offset {
+		t.Errorf("tape width = %d, want %d", tickerLayout.TapeWidth, offset)
+	}
+}
+
+// layoutWall creates a wall with dynamic ticker widths, scrolled to the start of the tape, with a
+// single screen of the given width.
+func layoutWall(leader *Leader, screenWidth int32, tickers ...*models.Ticker) *Wall {
+	wall := newWall(models.DefaultWall, &models.PresentationSettings{DynamicTickerWidths: true})
+	wall.Tickers = tickers
+	wall.Clients = []*UpdateClient{{
+		Screen: &models.Screen{UUID: "a", Width: screenWidth},
+		queue:  newSendQueue(),
+	}}
+	leader.Walls = map[string]*Wall{models.DefaultWall: wall}
+
+	leader.refreshWallLayout(wall, time.UnixMilli(0))
+	return wall
+}
+
+// setPrices changes the price of every ticker on the wall.
+func setPrices(wall *Wall, price float64) {
+	for _, ticker := range wall.Tickers {
+		wall.setPrice(ticker.Ticker, price)
+	}
+}
+
+func TestBoxesAreResizedOffScreen(t *testing.T) {
+	leader := &Leader{measurer: charMeasurer{}}
+	wall := layoutWall(leader, 0, &models.Ticker{Ticker: "AAPL"}, &models.Ticker{Ticker: "AMD"}, &models.Ticker{Ticker: "TSLA"})
+	checkLayout(t, wall.PresentationSettings.TickerLayout, "AAPL", "AMD", "TSLA")
+	before := wall.PresentationSettings.TickerLayout
+
+	// The screen shows exactly the first box.
+	first := before.Boxes[0]
+	wall.Clients[0].Screen.Width = first.Width
+
+	// Every box needs to grow for the new price.
+	setPrices(wall, 1000000)
+	now := time.UnixMilli(0)
+	newSettings := leader.refreshWallLayout(wall, now)
+	if newSettings == nil {
+		t.Fatal("layout didn't change, want the hidden boxes resized")
+	}
+	checkLayout(t, newSettings.TickerLayout, "AAPL", "AMD", "TSLA")
+
+	// The visible box keeps it's width, the others fit the new price.
+	if got := newSettings.TickerLayout.Box("AAPL").Width; got != first.Width {
+		t.Errorf("visible AAPL box width = %d, want it kept at %d", got, first.Width)
+	}
+	for _, ticker := range wall.Tickers[1:] {
+		want := layout.BoxWidth(leader.measurer, ticker, 0)
+		if got := newSettings.TickerLayout.Box(ticker.Ticker).Width; got != want || got == before.Box(ticker.Ticker).Width {
+			t.Errorf("hidden %s box width = %d, want it resized to %d", ticker.Ticker, got, want)
+		}
+	}
+
+	// Nothing changes while the first box is still on screen.
+	if newSettings := leader.refreshWallLayout(wall, now); newSettings != nil {
+		t.Errorf("layout changed to %v while the box was on screen", newSettings.TickerLayout)
+	}
+
+	// Once the tape has moved past it, the first box is resized too.
+	wall.PresentationSettings.ScrollEpoch = &models.ScrollEpoch{
+		TimestampMS: now.UnixMilli(),
+		Offset:      float64(newSettings.TickerLayout.Box("AMD").Offset),
+	}
+	newSettings = leader.refreshWallLayout(wall, now)
+	if newSettings == nil {
+		t.Fatal("layout didn't change, want the AAPL box resized")
+	}
+	checkLayout(t, newSettings.TickerLayout, "AAPL", "AMD", "TSLA")
+	if got, want := newSettings.TickerLayout.Box("AAPL").Width, layout.BoxWidth(leader.measurer, wall.Tickers[0], 0); got != want {
+		t.Errorf("AAPL box width = %d, want it resized to %d", got, want)
+	}
+}
+
+func TestShortTapesOnlyGrow(t *testing.T) {
+	leader := &Leader{measurer: charMeasurer{}}
+
+	// The screen is wider than the whole tape, so no box is ever hidden.
+	wall := layoutWall(leader, 100000, &models.Ticker{Ticker: "AAPL", Price: 100}, &models.Ticker{Ticker: "AMD", Price: 100})
+	before := wall.PresentationSettings.TickerLayout
+
+	// Smaller boxes would move everything on screen, so they keep their width.
+	setPrices(wall, 1)
+	if newSettings := leader.refreshWallLayout(wall, time.UnixMilli(0)); newSettings != nil {
+		t.Errorf("layout changed to %v, want the visible boxes kept", newSettings.TickerLayout)
+	}
+
+	// Content that doesn't fit grows the box anyways.
+	setPrices(wall, 1000000)
+	newSettings := leader.refreshWallLayout(wall, time.UnixMilli(0))
+	if newSettings == nil {
+		t.Fatal("layout didn't change, want the boxes grown")
+	}
+	checkLayout(t, newSettings.TickerLayout, "AAPL", "AMD")
+	for _, box := range newSettings.TickerLayout.Boxes {
+		if box.Width <= before.Box(box.Ticker).Width {
+			t.Errorf("%s box width = %d, want it wider than %d", box.Ticker, box.Width, before.Box(box.Ticker).Width)
+		}
+	}
+}
+
+func TestFixedWidthsAreNotResized(t *testing.T) {
+	leader := &Leader{measurer: charMeasurer{}}
+	wall := layoutWall(leader, 0, &models.Ticker{Ticker: "AAPL"}, &models.Ticker{Ticker: "AMD"})
+	wall.PresentationSettings.DynamicTickerWidths = false
+
+	setPrices(wall, 1000000)
+	if newSettings := leader.refreshWallLayout(wall, time.UnixMilli(0)); newSettings != nil {
+		t.Errorf("layout changed to %v, want fixed widths left alone", newSettings.TickerLayout)
+	}
+}
+
+func TestLayoutFollowsTickers(t *testing.T) {
+	ctx := context.Background()
+	leader, err := New(&Config{
+		TickerList:   "AMD,TSLA",
+		Source:       SourceSim,
+		Presentation: &models.PresentationSettings{DynamicTickerWidths: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wall := leader.Walls[models.DefaultWall]
+
+	// The layout loop lays out the starting tickers.
+	leader.refreshTickerLayouts()
+	leader.RLock()
+	before := wall.PresentationSettings.TickerLayout
+	leader.RUnlock()
+	checkLayout(t, before, "AMD", "TSLA")
+
+	// The new ticker is put in it's place on the tape, and everything after it moves along.
+	if _, err := leader.AddTicker(ctx, &models.TickerRequest{Ticker: "AAPL"}); err != nil {
+		t.Fatal(err)
+	}
+	leader.RLock()
+	added := wall.PresentationSettings.TickerLayout
+	leader.RUnlock()
+	checkLayout(t, added, "AAPL", "AMD", "TSLA")
+	for _, box := range before.Boxes {
+		if got := added.Box(box.Ticker).Width; got != box.Width {
+			t.Errorf("%s box width = %d after adding a ticker, want it kept at %d", box.Ticker, got, box.Width)
+		}
+	}
+
+	if _, err := leader.RemoveTicker(ctx, &models.TickerRequest{Ticker: "AMD"}); err != nil {
+		t.Fatal(err)
+	}
+	leader.RLock()
+	removed := wall.PresentationSettings.TickerLayout
+	leader.RUnlock()
+	checkLayout(t, removed, "AAPL", "TSLA")
+	if got, want := removed.Box("TSLA").Offset, added.Box("AAPL").Width; got != want {
+		t.Errorf("TSLA box offset = %d after removing AMD, want %d", got, want)
+	}
+}
@@ -0,0 +1,169 @@
+package leader
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// AddTicker loads the details of a new ticker, adds it to a wall and starts streaming its price
+// updates.
+func (t *Leader) AddTicker(ctx context.Context, req *models.TickerRequest) (*models.Ticker, error) {
+	symbol := strings.ToUpper(strings.TrimSpace(req.Ticker))
+	wallName := models.WallName(req.Wall)
+	logrus.WithFields(logrus.Fields{
+		"wall":   wallName,
+		"ticker": symbol,
+	}).Debug("Adding ticker..")
+
+	if symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "ticker is required")
+	}
+
+	if t.DataClient == nil {
+		return nil, status.Error(codes.FailedPrecondition, "tickers cannot be changed while replaying a recording")
+	}
+
+	if t.hasTicker(wallName, symbol) {
+		return nil, status.Errorf(codes.AlreadyExists, "ticker %s is already on the %s wall", symbol, wallName)
+	}
+
+	// Load the details first, this also makes sure it's a valid ticker.
+	ticker, err := t.DataClient.LoadTickerData(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load ticker details: %w", err)
+	}
+
+	aggs, err := t.DataClient.GetTickerTodayAggs(ctx, getCurrentOrPreviousWeekday(time.Now()), symbol, 10)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get todays aggs for ticker: %w", err)
+	}
+	ticker.Aggs = aggs
+
+	t.Lock()
+	wall, err := t.findWall(wallName)
+	if err != nil {
+		t.Unlock()
+		return nil, err
+	}
+	// Make sure it wasn't added while we were loading details.
+	if wall.hasTicker(symbol) {
+		t.Unlock()
+		return nil, status.Errorf(codes.AlreadyExists, "ticker %s is already on the %s wall", symbol, wallName)
+	}
+	// We only need to subscribe if no other wall is already streaming it.
+	subscribe := !t.isTickerOnAnyWall(symbol)
+	tickers := append(wall.Tickers, ticker)
+	newSettings := t.relayoutWall(wall, tickers, scrollNow())
+	wall.Tickers = tickers
+	// Send the layout first, so screens know where to put the new ticker.
+	wall.publish(&models.Update{
+		UpdateType:           int32(models.UpdatePresentationSettings),
+		PresentationSettings: newSettings,
+	})
+	// Copied, the walls ticker is replaced when it's price changes but it's copies aren't.
+	added := proto.Clone(ticker).(*models.Ticker)
+	wall.publish(&models.Update{
+		UpdateType: int32(models.UpdateTypeTickerAdded),
+		Ticker:     proto.Clone(added).(*models.Ticker),
+	})
+	t.Unlock()
+
+	t.audit(ctx, "AddTicker", wall.Name, symbol, nil, auditedTicker(ticker))
+
+	if subscribe {
+		if err := t.DataClient.Subscribe(symbol); err != nil {
+			logrus.WithError(err).Error("Unable to subscribe to price updates.")
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"wall":   wallName,
+		"ticker": symbol,
+	}).Info("Ticker added.")
+
+	return added, nil
+}
+
+// RemoveTicker removes a ticker from a wall and stops streaming its price updates, if no other
+// wall needs them.
+func (t *Leader) RemoveTicker(ctx context.Context, req *models.TickerRequest) (*models.Ticker, error) {
+	symbol := strings.ToUpper(strings.TrimSpace(req.Ticker))
+	wallName := models.WallName(req.Wall)
+	logrus.WithFields(logrus.Fields{
+		"wall":   wallName,
+		"ticker": symbol,
+	}).Debug("Removing ticker..")
+
+	if t.DataClient == nil {
+		return nil, status.Error(codes.FailedPrecondition, "tickers cannot be changed while replaying a recording")
+	}
+
+	t.Lock()
+	wall, err := t.findWall(wallName)
+	if err != nil {
+		t.Unlock()
+		return nil, err
+	}
+
+	var removed *models.Ticker
+	for _, ticker := range wall.Tickers {
+		if ticker.Ticker == symbol {
+			removed = ticker
+			break
+		}
+	}
+	if removed == nil {
+		t.Unlock()
+		return nil, status.Errorf(codes.NotFound, "ticker %s is not on the %s wall", symbol, wallName)
+	}
+	tickers := removeTicker(wall.Tickers, symbol)
+	newSettings := t.relayoutWall(wall, tickers, scrollNow())
+	wall.Tickers = tickers
+	unsubscribe := !t.isTickerOnAnyWall(symbol)
+	removed = proto.Clone(removed).(*models.Ticker)
+	wall.publish(&models.Update{
+		UpdateType: int32(models.UpdateTypeTickerRemoved),
+		Ticker:     proto.Clone(removed).(*models.Ticker),
+	})
+	wall.publish(&models.Update{
+		UpdateType:           int32(models.UpdatePresentationSettings),
+		PresentationSettings: newSettings,
+	})
+	t.Unlock()
+
+	t.audit(ctx, "RemoveTicker", wall.Name, symbol, auditedTicker(removed), nil)
+
+	if unsubscribe {
+		if err := t.DataClient.Unsubscribe(symbol); err != nil {
+			logrus.WithError(err).Error("Unable to unsubscribe from price updates.")
+		}
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"wall":   wallName,
+		"ticker": symbol,
+	}).Info("Ticker removed.")
+
+	return removed, nil
+}
+
+// hasTicker checks if the ticker is currently on the wall.
+func (t *Leader) hasTicker(wallName, symbol string) bool {
+	t.RLock()
+	defer t.RUnlock()
+
+	wall, err := t.findWall(wallName)
+	if err != nil {
+		return false
+	}
+
+	return wall.hasTicker(symbol)
+}
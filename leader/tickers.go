@@ -10,7 +10,7 @@ import (
 )
 
 func (t *Leader) refreshTickerAggs(ctx context.Context) error {
-	for _, ticker := range t.Tickers {
+	for _, symbol := range t.getTickerSymbols() {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
@@ -21,31 +21,28 @@ func (t *Leader) refreshTickerAggs(ctx context.Context) error {
 
 		// Get the agg data.
 		today := getCurrentOrPreviousWeekday(time.Now())
-		aggs, err := t.DataClient.GetTickerTodayAggs(timeoutCtx, today, ticker.Ticker, 10)
+		aggs, err := t.DataClient.GetTickerTodayAggs(timeoutCtx, today, symbol, 10)
 		if err != nil {
 			return fmt.Errorf("unable to get todays aggs for ticker: %w", err)
 		}
 
 		logrus.WithFields(logrus.Fields{
 			"count":  len(aggs),
-			"ticker": ticker.Ticker,
+			"ticker": symbol,
 		}).Debug("Got aggregates")
 
 		// TODO: Normalize the aggregates for a time window.
 		// We want gaps in the agg bars to be filled to convery an accurate
 		// representation of time.
 
-		// This ticker actually has changes
-		if len(ticker.Aggs) != len(aggs) {
-			// Lock and update ticker data.
-			t.Lock()
-			ticker.Aggs = aggs
-			t.Unlock()
-			t.Updates <- &models.Update{
-				UpdateType: int32(models.UpdateTypeTickerUpdate),
-				Ticker:     ticker,
+		t.updateTicker(symbol, func(ticker *models.Ticker) bool {
+			// This ticker actually has changes
+			if len(ticker.Aggs) == len(aggs) {
+				return false
 			}
-		}
+			ticker.Aggs = aggs
+			return true
+		})
 
 	}
 
@@ -63,7 +60,7 @@ func getCurrentOrPreviousWeekday(today time.Time) time.Time {
 }
 
 func (t *Leader) refreshTickerDetails(ctx context.Context, firstRun bool) error {
-	for _, ticker := range t.Tickers {
+	for _, symbol := range t.getTickerSymbols() {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
@@ -73,24 +70,21 @@ func (t *Leader) refreshTickerDetails(ctx context.Context, firstRun bool) error
 		defer cancel()
 
 		// Get details.
-		tickerDetails, err := t.DataClient.LoadTickerData(timeoutCtx, ticker.Ticker)
+		tickerDetails, err := t.DataClient.LoadTickerData(timeoutCtx, symbol)
 		if err != nil {
 			return err
 		}
 
 		// Update with details
-		t.Lock()
-		ticker.CompanyName = tickerDetails.CompanyName
-		ticker.PreviousClosePrice = tickerDetails.PreviousClosePrice
-		ticker.OutstandingShares = tickerDetails.OutstandingShares
-		if firstRun {
-			ticker.Price = tickerDetails.Price
-		}
-		t.Unlock()
-		t.Updates <- &models.Update{
-			UpdateType: int32(models.UpdateTypeTickerUpdate),
-			Ticker:     ticker,
-		}
+		t.updateTicker(symbol, func(ticker *models.Ticker) bool {
+			ticker.CompanyName = tickerDetails.CompanyName
+			ticker.PreviousClosePrice = tickerDetails.PreviousClosePrice
+			ticker.OutstandingShares = tickerDetails.OutstandingShares
+			if firstRun {
+				ticker.Price = tickerDetails.Price
+			}
+			return true
+		})
 	}
 	return nil
 }
@@ -2,24 +2,68 @@ package leader
 
 import (
 	"context"
+	"strings"
 
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
-// UpdatePresentationSettings updates the presentation settings of the cluster and sends out an
-// update to all clients.
-func (t *Leader) UpdatePresentationSettings(ctx context.Context, newSettings *models.PresentationSettings) (*models.PresentationSettings, error) {
-	logrus.Debug("Update presentation settings..", newSettings)
+// UpdatePresentationSettings updates the presentation settings of a wall and sends out an update to
+// all of it's clients. Only the fields listed in the update mask are changed.
+func (t *Leader) UpdatePresentationSettings(ctx context.Context, req *models.UpdatePresentationSettingsRequest) (*models.PresentationSettings, error) {
+	logrus.Debug("Update presentation settings..", req)
+
+	if req.PresentationSettings == nil {
+		req.PresentationSettings = &models.PresentationSettings{}
+	}
+
+	// The scroll epoch and ticker layout are managed by the leader.
+	for _, path := range req.UpdateMask.GetPaths() {
+		for _, managed := range []string{"ScrollEpoch", "TickerLayout"} {
+			if path == managed || strings.HasPrefix(path, managed+".") {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid update mask: %s cannot be updated", managed)
+			}
+		}
+	}
 
 	t.Lock()
-	t.PresentationSettings = newSettings
-	t.Unlock()
+	wall, err := t.findWall(req.Wall)
+	if err != nil {
+		t.Unlock()
+		return nil, err
+	}
+	oldSettings := wall.PresentationSettings
+	// Apply the changes to a copy, the current settings may be in the middle of being sent to clients.
+	newSettings := proto.Clone(oldSettings).(*models.PresentationSettings)
+	if err := applyFieldMask(newSettings, req.PresentationSettings, req.UpdateMask.GetPaths()); err != nil {
+		t.Unlock()
+		return nil, status.Errorf(codes.InvalidArgument, "invalid update mask: %v", err)
+	}
+	// An empty mask replaces everything, but the leader still manages these.
+	newSettings.ScrollEpoch = oldSettings.ScrollEpoch
+	newSettings.TickerLayout = oldSettings.TickerLayout
 
-	t.Updates <- &models.Update{
-		UpdateType:           int32(models.UpdatePresentationSettings),
-		PresentationSettings: t.PresentationSettings,
+	// The boxes need to be sized again when the way we size them changes.
+	if newSettings.TickerLayout == nil ||
+		newSettings.TickerBoxWidth != oldSettings.TickerBoxWidth ||
+		newSettings.DynamicTickerWidths != oldSettings.DynamicTickerWidths {
+		newSettings.TickerLayout = layoutTickers(wall.Tickers, func(ticker *models.Ticker) int32 {
+			return t.tickerWidth(newSettings, ticker)
+		})
 	}
 
-	return t.PresentationSettings, nil
+	// Continue scrolling from where the tape currently is, in the same ticker.
+	wall.applySettings(newSettings, scrollNow())
+	wall.publish(&models.Update{
+		UpdateType:           int32(models.UpdatePresentationSettings),
+		PresentationSettings: newSettings,
+	})
+	t.Unlock()
+
+	t.audit(ctx, "UpdatePresentationSettings", wall.Name, "", auditedSettings(oldSettings), auditedSettings(newSettings))
+
+	return newSettings, nil
 }
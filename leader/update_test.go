@@ -0,0 +1,66 @@
+package leader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdatePresentationSettingsKeepsLayout(t *testing.T) {
+	ctx := context.Background()
+	leader, err := New(&Config{
+		TickerList:   "AAPL,AMD",
+		Source:       SourceSim,
+		Presentation: &models.PresentationSettings{TickerBoxWidth: 1100, DynamicTickerWidths: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := leader.UpdatePresentationSettings(ctx, &models.UpdatePresentationSettingsRequest{
+		PresentationSettings: &models.PresentationSettings{ScrollSpeed: 5},
+		UpdateMask:           &fieldmaskpb.FieldMask{Paths: []string{"ScrollSpeed"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settings.TickerLayout.GetBoxes()) != 2 {
+		t.Fatalf("layout = %v, want a box for each ticker", settings.TickerLayout)
+	}
+	layout := settings.TickerLayout
+
+	// Replacing all of the settings doesn't replace the ones the leader manages.
+	clientEpoch := &models.ScrollEpoch{TimestampMS: 1}
+	settings, err = leader.UpdatePresentationSettings(ctx, &models.UpdatePresentationSettingsRequest{
+		PresentationSettings: &models.PresentationSettings{
+			TickerBoxWidth:      1100,
+			DynamicTickerWidths: true,
+			ScrollSpeed:         5,
+			ScrollEpoch:         clientEpoch,
+			TickerLayout:        &models.TickerLayout{TapeWidth: 1},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(settings.TickerLayout, layout) {
+		t.Errorf("layout = %v, want %v", settings.TickerLayout, layout)
+	}
+	if proto.Equal(settings.ScrollEpoch, clientEpoch) {
+		t.Error("the scroll epoch was replaced by the clients")
+	}
+
+	// Without a layout, the tickers are laid out again.
+	settings, err = leader.UpdatePresentationSettings(ctx, &models.UpdatePresentationSettingsRequest{
+		PresentationSettings: &models.PresentationSettings{TickerBoxWidth: 900},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(settings.TickerLayout.GetBoxes()) != 2 {
+		t.Errorf("layout = %v, want a box for each ticker", settings.TickerLayout)
+	}
+}
@@ -5,9 +5,14 @@ import "github.com/polygon-io/go-app-ticker-wall/models"
 // UpdateClientSlice is sortable. fancy.
 type UpdateClientSlice []*UpdateClient
 
-func (a UpdateClientSlice) Len() int           { return len(a) }
-func (a UpdateClientSlice) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a UpdateClientSlice) Less(i, j int) bool { return a[i].Screen.Index < a[j].Screen.Index }
+func (a UpdateClientSlice) Len() int      { return len(a) }
+func (a UpdateClientSlice) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a UpdateClientSlice) Less(i, j int) bool {
+	if a[i].Screen.Row != a[j].Screen.Row {
+		return a[i].Screen.Row < a[j].Screen.Row
+	}
+	return a[i].Screen.Index < a[j].Screen.Index
+}
 
 // constructRGBA is a helper which turns our env variables ( map of string -> int32 ) into a struct.
 func constructRGBA(colorMap map[string]int32) *models.RGBA {
@@ -18,3 +23,28 @@ func constructRGBA(colorMap map[string]int32) *models.RGBA {
 		Alpha: colorMap["alpha"],
 	}
 }
+
+// upsertTicker replaces the ticker with the same symbol, or appends it if we don't have it yet.
+func upsertTicker(tickers []*models.Ticker, ticker *models.Ticker) []*models.Ticker {
+	for i, t := range tickers {
+		if t.Ticker == ticker.Ticker {
+			tickers[i] = ticker
+			return tickers
+		}
+	}
+
+	return append(tickers, ticker)
+}
+
+// removeTicker returns the tickers without the one with the given symbol. The tickers are copied
+// to a new slice, the old one may still be used by a response or an update.
+func removeTicker(tickers []*models.Ticker, symbol string) []*models.Ticker {
+	res := make([]*models.Ticker, 0, len(tickers))
+	for _, t := range tickers {
+		if t.Ticker != symbol {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
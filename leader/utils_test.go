@@ -0,0 +1,22 @@
+package leader
+
+import (
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+func TestRemoveTickerKeepsTheOldSlice(t *testing.T) {
+	tickers := []*models.Ticker{{Ticker: "AAPL"}, {Ticker: "AMD"}, {Ticker: "NVDA"}}
+	removed := removeTicker(tickers, "AAPL")
+	if len(removed) != 2 || removed[0].Ticker != "AMD" || removed[1].Ticker != "NVDA" {
+		t.Errorf("removeTicker() = %v, want AMD and NVDA", removed)
+	}
+	if tickers[0].Ticker != "AAPL" || tickers[1].Ticker != "AMD" || tickers[2].Ticker != "NVDA" {
+		t.Errorf("removeTicker() changed the old slice to %v", tickers)
+	}
+
+	if got := removeTicker(tickers, "TSLA"); len(got) != 3 {
+		t.Errorf("removeTicker() of a missing ticker = %v, want all of them", got)
+	}
+}
@@ -0,0 +1,273 @@
+package leader
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Wall is an independent ticker wall served by the leader. Each wall has it's own screens,
+// tickers, settings and announcements. Walls are protected by the leaders lock.
+type Wall struct {
+	Name string
+
+	// This keeps the presentation settings.
+	PresentationSettings *models.PresentationSettings
+
+	// Our list of tickers we want to display.
+	Tickers []*models.Ticker
+
+	// Announcements which are still being displayed, so they can be sent to screens which join late.
+	Announcements []*models.Announcement
+
+	// List of clients who are listening for updates.
+	Clients []*UpdateClient
+
+	// Screens disconnected because they fell too far behind.
+	slowScreensDisconnected int64
+
+	// sequence is the sequence number of the walls last update.
+	sequence int64
+}
+
+// newWall creates an empty wall with the given settings.
+func newWall(name string, settings *models.PresentationSettings) *Wall {
+	return &Wall{
+		Name:                 name,
+		PresentationSettings: settings,
+	}
+}
+
+// findWall gets a wall by name. Must be called while holding the lock.
+func (t *Leader) findWall(name string) (*Wall, error) {
+	name = models.WallName(name)
+
+	wall, ok := t.Walls[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "wall %s does not exist", name)
+	}
+	return wall, nil
+}
+
+// createWall gets a wall by name, creating it if it doesn't exist yet. New walls start as a copy of
+// the default wall's settings and tickers. Walls are only created from the config, the saved state
+// and CreateWall, everything else uses findWall so a mistyped wall name doesn't become a new wall.
+// Must be called while holding the write lock.
+func (t *Leader) createWall(name string) *Wall {
+	name = models.WallName(name)
+	if wall, ok := t.Walls[name]; ok {
+		return wall
+	}
+
+	defaultWall := t.Walls[models.DefaultWall]
+	wall := newWall(name, proto.Clone(defaultWall.PresentationSettings).(*models.PresentationSettings))
+	for _, ticker := range defaultWall.Tickers {
+		wall.Tickers = append(wall.Tickers, proto.Clone(ticker).(*models.Ticker))
+	}
+
+	t.Walls[name] = wall
+	return wall
+}
+
+// wallNames gets the names of all of our walls, sorted. Must be called while holding the lock.
+func (t *Leader) wallNames() []string {
+	names := make([]string, 0, len(t.Walls))
+	for name := range t.Walls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ListWalls returns the names of the walls served by the leader.
+func (t *Leader) ListWalls(ctx context.Context, empty *models.Empty) (*models.Walls, error) {
+	t.RLock()
+	defer t.RUnlock()
+
+	return &models.Walls{
+		Walls: t.wallNames(),
+	}, nil
+}
+
+// CreateWall creates a wall, which starts as a copy of the default wall's settings and tickers.
+func (t *Leader) CreateWall(ctx context.Context, req *models.WallRequest) (*models.Walls, error) {
+	if strings.TrimSpace(req.Wall) == "" {
+		return nil, status.Error(codes.InvalidArgument, "wall is required")
+	}
+	name := models.WallName(req.Wall)
+
+	t.Lock()
+	if _, ok := t.Walls[name]; ok {
+		t.Unlock()
+		return nil, status.Errorf(codes.AlreadyExists, "wall %s already exists", name)
+	}
+	created := t.createWall(name).state()
+	names := t.wallNames()
+	t.Unlock()
+
+	t.audit(ctx, "CreateWall", name, "", nil, created)
+
+	logrus.WithField("wall", name).Info("Wall created.")
+
+	return &models.Walls{
+		Walls: names,
+	}, nil
+}
+
+// DeleteWall deletes a wall, disconnecting it's screens. The default wall can't be deleted.
+func (t *Leader) DeleteWall(ctx context.Context, req *models.WallRequest) (*models.Walls, error) {
+	name := models.WallName(req.Wall)
+	if name == models.DefaultWall {
+		return nil, status.Error(codes.FailedPrecondition, "the default wall can't be deleted")
+	}
+
+	t.Lock()
+	wall, err := t.findWall(name)
+	if err != nil {
+		t.Unlock()
+		return nil, err
+	}
+	delete(t.Walls, name)
+
+	// The screens can't re-join, the wall is gone.
+	for _, client := range wall.Clients {
+		client.kick()
+	}
+
+	// Stop streaming the tickers which no other wall needs.
+	var unsubscribe []string
+	for _, ticker := range wall.Tickers {
+		if !t.isTickerOnAnyWall(ticker.Ticker) {
+			unsubscribe = append(unsubscribe, ticker.Ticker)
+		}
+	}
+	wall.pruneAnnouncements()
+	deleted := wall.state()
+	names := t.wallNames()
+	t.Unlock()
+
+	t.audit(ctx, "DeleteWall", name, "", deleted, nil)
+
+	// There's no data client while replaying.
+	if t.DataClient != nil {
+		for _, symbol := range unsubscribe {
+			if err := t.DataClient.Unsubscribe(symbol); err != nil {
+				logrus.WithError(err).Error("Unable to unsubscribe from price updates.")
+			}
+		}
+	}
+
+	logrus.WithField("wall", name).Info("Wall deleted.")
+
+	return &models.Walls{
+		Walls: names,
+	}, nil
+}
+
+// state is what is persisted of the wall. Must be called while holding the lock.
+func (w *Wall) state() *models.WallState {
+	state := &models.WallState{
+		Name:                 w.Name,
+		PresentationSettings: w.PresentationSettings,
+		Announcements:        w.Announcements,
+	}
+	for _, ticker := range w.Tickers {
+		state.Tickers = append(state.Tickers, ticker.Ticker)
+	}
+	return state
+}
+
+// broadcast queues a price update to be sent to all of the clients of it's wall, or every wall
+// when it doesn't have one. Prices aren't numbered, the walls other updates are sent by publish.
+func (t *Leader) broadcast(update *models.Update) {
+	t.Updates <- update
+}
+
+// publish gives an update the walls next sequence number, and queues it for each of the walls
+// clients. Must be called while holding the write lock, in the same critical section as the change
+// it sends out, so a snapshot never includes a change without it's update being numbered and the
+// clients queue the updates in sequence order. Queueing doesn't block, slow clients are dealt with
+// by their queue.
+func (w *Wall) publish(update *models.Update) {
+	w.sequence++
+	update.Wall = w.Name
+	update.Sequence = w.sequence
+
+	now := time.Now()
+	for _, client := range w.Clients {
+		client.queue.push(update, now)
+	}
+}
+
+// snapshot is the full state of the wall. Must be called while holding the write lock.
+func (w *Wall) snapshot() *models.Snapshot {
+	w.pruneAnnouncements()
+
+	snapshot := &models.Snapshot{
+		Wall:          w.Name,
+		Sequence:      w.sequence,
+		ScreenCluster: w.screenCluster(),
+		Announcements: append([]*models.Announcement(nil), w.Announcements...),
+	}
+
+	// Copied, the prices keep changing while the snapshot is waiting to be sent.
+	for _, ticker := range w.Tickers {
+		snapshot.Tickers = append(snapshot.Tickers, proto.Clone(ticker).(*models.Ticker))
+	}
+
+	return snapshot
+}
+
+// GetSnapshot returns the full state of a wall, as of it's latest update.
+func (t *Leader) GetSnapshot(ctx context.Context, req *models.WallRequest) (*models.Snapshot, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	wall, err := t.findWall(req.Wall)
+	if err != nil {
+		return nil, err
+	}
+
+	return wall.snapshot(), nil
+}
+
+// hasTicker checks if the ticker is on the wall. Must be called while holding the lock.
+func (w *Wall) hasTicker(symbol string) bool {
+	for _, ticker := range w.Tickers {
+		if ticker.Ticker == symbol {
+			return true
+		}
+	}
+	return false
+}
+
+// screenCluster creates a ScreenCluster model from the walls current clients. Must be called
+// while holding the lock.
+func (w *Wall) screenCluster() *models.ScreenCluster {
+	res := &models.ScreenCluster{
+		Wall:                    w.Name,
+		Settings:                w.PresentationSettings,
+		SlowScreensDisconnected: w.slowScreensDisconnected,
+	}
+
+	for _, client := range w.Clients {
+		// Observers are not part of the screen layout.
+		if client.Screen.Observer {
+			continue
+		}
+
+		// Copied, the screen may be in the middle of being sent to clients.
+		screen := proto.Clone(client.Screen).(*models.Screen)
+		screen.SendStats = client.queue.stats()
+		res.Screens = append(res.Screens, screen)
+	}
+
+	return res
+}
@@ -0,0 +1,160 @@
+package leader
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestScreensStartWithSnapshot(t *testing.T) {
+	wall := newWall(models.DefaultWall, &models.PresentationSettings{})
+	wall.Tickers = []*models.Ticker{{Ticker: "AAPL"}}
+	leader := &Leader{
+		Walls:   map[string]*Wall{models.DefaultWall: wall},
+		Updates: make(chan *models.Update, 10),
+	}
+
+	// Something happened before the screen joined.
+	wall.publish(&models.Update{UpdateType: int32(models.UpdateTypeTickerUpdate)})
+
+	client := &UpdateClient{
+		Screen: &models.Screen{UUID: "a", Wall: models.DefaultWall},
+		queue:  newSendQueue(),
+	}
+	if err := leader.addScreenToCluster(client); err != nil {
+		t.Fatal(err)
+	}
+
+	// The snapshot comes first, then the cluster update adding the screen.
+	updates := drain(client.queue)
+	if len(updates) != 2 || updates[0].UpdateType != int32(models.UpdateTypeSnapshot) {
+		t.Fatalf("queued %v, want a snapshot and the cluster update", updates)
+	}
+	snapshot := updates[0].Snapshot
+	if len(snapshot.Tickers) != 1 || len(snapshot.ScreenCluster.Screens) != 1 || snapshot.Sequence != 1 {
+		t.Errorf("snapshot = %v, want the ticker and the new screen at sequence 1", snapshot)
+	}
+	if update := updates[1]; update.UpdateType != int32(models.UpdateTypeCluster) || update.Sequence != 2 {
+		t.Errorf("update after the snapshot = %v, want the cluster update at sequence 2", update)
+	}
+}
+
+func TestUpdatesAreQueuedInSequenceOrder(t *testing.T) {
+	wall := newWall(models.DefaultWall, &models.PresentationSettings{})
+	wall.Tickers = []*models.Ticker{{Ticker: "AAPL"}}
+	leader := &Leader{
+		Walls:   map[string]*Wall{models.DefaultWall: wall},
+		Updates: make(chan *models.Update, 10),
+	}
+	client := &UpdateClient{
+		Screen: &models.Screen{UUID: "a", Wall: models.DefaultWall},
+		queue:  newSendQueue(),
+	}
+	if err := leader.addScreenToCluster(client); err != nil {
+		t.Fatal(err)
+	}
+
+	// Changes made at the same time still reach the screen in order.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				leader.updateTicker("AAPL", func(ticker *models.Ticker) bool { return true })
+			}
+		}()
+	}
+	wg.Wait()
+
+	updates := drain(client.queue)
+	if len(updates) != 402 {
+		t.Fatalf("queued %d updates, want 402", len(updates))
+	}
+	for i, update := range updates[1:] {
+		if update.Sequence != int64(i+1) {
+			t.Fatalf("update %d has sequence %d, want %d", i+1, update.Sequence, i+1)
+		}
+	}
+}
+
+func TestWallsAreOnlyCreatedExplicitly(t *testing.T) {
+	ctx := context.Background()
+	leader, err := New(&Config{
+		TickerList:   "AAPL,AMD",
+		WallList:     "lobby, floor",
+		Source:       SourceSim,
+		Presentation: &models.PresentationSettings{ScrollSpeed: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walls, _ := leader.ListWalls(ctx, &models.Empty{})
+	if strings.Join(walls.Walls, ",") != "default,floor,lobby" {
+		t.Fatalf("walls = %v, want the default wall and the configured ones", walls.Walls)
+	}
+
+	// Mistyped walls don't become new walls.
+	_, err = leader.UpdatePresentationSettings(ctx, &models.UpdatePresentationSettingsRequest{
+		Wall:                 "lobyb",
+		PresentationSettings: &models.PresentationSettings{ScrollSpeed: 2},
+		UpdateMask:           &fieldmaskpb.FieldMask{Paths: []string{"ScrollSpeed"}},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("UpdatePresentationSettings() of a missing wall = %v, want NotFound", err)
+	}
+	if _, err := leader.AddTicker(ctx, &models.TickerRequest{Wall: "lobyb", Ticker: "TSLA"}); status.Code(err) != codes.NotFound {
+		t.Errorf("AddTicker() to a missing wall = %v, want NotFound", err)
+	}
+	client := &UpdateClient{Screen: &models.Screen{UUID: "a", Wall: "lobyb"}, queue: newSendQueue()}
+	if err := leader.addScreenToCluster(client); status.Code(err) != codes.NotFound {
+		t.Errorf("addScreenToCluster() to a missing wall = %v, want NotFound", err)
+	}
+
+	// Created walls start as a copy of the default wall.
+	if _, err := leader.CreateWall(ctx, &models.WallRequest{Wall: "Kitchen"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := leader.CreateWall(ctx, &models.WallRequest{Wall: "kitchen"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateWall() of an existing wall = %v, want AlreadyExists", err)
+	}
+	tickers, err := leader.ListTickers(ctx, &models.WallRequest{Wall: "kitchen"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tickers.Tickers) != 2 {
+		t.Errorf("tickers = %v, want the default walls", tickers.Tickers)
+	}
+
+	// Deleting a wall disconnects it's screens.
+	client = &UpdateClient{Screen: &models.Screen{UUID: "a", Wall: "kitchen"}, queue: newSendQueue(), kicked: make(chan struct{})}
+	if err := leader.addScreenToCluster(client); err != nil {
+		t.Fatal(err)
+	}
+	walls, err = leader.DeleteWall(ctx, &models.WallRequest{Wall: "kitchen"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(walls.Walls, ",") != "default,floor,lobby" {
+		t.Errorf("walls = %v, want kitchen deleted", walls.Walls)
+	}
+	select {
+	case <-client.kicked:
+	default:
+		t.Error("the screen of the deleted wall wasn't kicked")
+	}
+	if err := leader.removeScreenFromCluster(client); err != nil {
+		t.Errorf("removeScreenFromCluster() of a deleted wall = %v", err)
+	}
+
+	if _, err := leader.DeleteWall(ctx, &models.WallRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteWall() of the default wall = %v, want FailedPrecondition", err)
+	}
+}
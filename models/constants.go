@@ -20,6 +20,18 @@ const (
 	UpdateTypePrice UpdateType = 6
 	// UpdatePresentationSettings means presentation settings have been updated.
 	UpdatePresentationSettings UpdateType = 7
+	// UpdateTypeSnapshot replaces the screens state with a snapshot of it's wall.
+	UpdateTypeSnapshot UpdateType = 8
+)
+
+// Protocol versions of the screen update stream.
+const (
+	// ProtocolV1 is the JoinCluster stream of the Leader service, where the contents of an update
+	// are given by it's UpdateType. Screens which don't send a protocol version speak it.
+	ProtocolV1 = 1
+	// ProtocolV2 is the JoinCluster stream of the v2 Leader service, which starts with a handshake
+	// and has oneof update payloads.
+	ProtocolV2 = 2
 )
 
 // AnnouncementType is used to signify the type of announcement / alert. Different announcement types behave differently.
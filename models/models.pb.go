@@ -13,6 +13,8 @@ import (
 	status "google.golang.org/grpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -278,11 +280,14 @@ type Announcement struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message           string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
-	AnnouncementType  int32  `protobuf:"varint,2,opt,name=AnnouncementType,proto3" json:"AnnouncementType,omitempty"`
+	Message          string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	AnnouncementType int32  `protobuf:"varint,2,opt,name=AnnouncementType,proto3" json:"AnnouncementType,omitempty"`
+	// When to show the announcement. Announcements are scheduled when this is in the future,
+	// otherwise they are shown right away.
 	ShowAtTimestampMS int64  `protobuf:"varint,3,opt,name=ShowAtTimestampMS,proto3" json:"ShowAtTimestampMS,omitempty"`
 	LifespanMS        int64  `protobuf:"varint,4,opt,name=LifespanMS,proto3" json:"LifespanMS,omitempty"`
 	Animation         int32  `protobuf:"varint,5,opt,name=Animation,proto3" json:"Animation,omitempty"`
+	Wall              string `protobuf:"bytes,6,opt,name=Wall,proto3" json:"Wall,omitempty"`
 }
 
 func (x *Announcement) Reset() {
@@ -352,6 +357,13 @@ func (x *Announcement) GetAnimation() int32 {
 	return 0
 }
 
+func (x *Announcement) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
 // Screen contains all screen information about an individual screen.
 type Screen struct {
 	state         protoimpl.MessageState
@@ -362,6 +374,27 @@ type Screen struct {
 	Width  int32  `protobuf:"varint,2,opt,name=Width,proto3" json:"Width,omitempty"`
 	Height int32  `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
 	Index  int32  `protobuf:"varint,4,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Observers receive cluster updates but are not part of the screen layout ( eg: recorders ).
+	Observer bool `protobuf:"varint,5,opt,name=Observer,proto3" json:"Observer,omitempty"`
+	// Last measured offset of the screens clock from the leaders clock, and the round trip time it
+	// was measured with. Reported by the screen when syncing it's clock.
+	ClockOffsetNS    int64 `protobuf:"varint,6,opt,name=ClockOffsetNS,proto3" json:"ClockOffsetNS,omitempty"`
+	ClockRoundTripNS int64 `protobuf:"varint,7,opt,name=ClockRoundTripNS,proto3" json:"ClockRoundTripNS,omitempty"`
+	// Physical layout of the screen, in pixels. The bezels are the borders on each side of the
+	// screen and the gap is any space between this screen and the next one.
+	BezelLeft  int32 `protobuf:"varint,8,opt,name=BezelLeft,proto3" json:"BezelLeft,omitempty"`
+	BezelRight int32 `protobuf:"varint,9,opt,name=BezelRight,proto3" json:"BezelRight,omitempty"`
+	Gap        int32 `protobuf:"varint,10,opt,name=Gap,proto3" json:"Gap,omitempty"`
+	// Row of the screen, for walls with more than one row of screens. Screens are ordered by row,
+	// then index, and each row continues the tape from the end of the row above it.
+	Row int32 `protobuf:"varint,11,opt,name=Row,proto3" json:"Row,omitempty"`
+	// Wall the screen is part of. Empty is the default wall.
+	Wall string `protobuf:"bytes,12,opt,name=Wall,proto3" json:"Wall,omitempty"`
+	// How the leader is keeping up with sending the screen updates. Set by the leader.
+	SendStats *SendStats `protobuf:"bytes,13,opt,name=SendStats,proto3" json:"SendStats,omitempty"`
+	// Protocol version the screen speaks. Screens send the newest version they support, and the
+	// leader sets it to the version it's updates are sent with. See models/v2.
+	ProtocolVersion int32 `protobuf:"varint,14,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"`
 }
 
 func (x *Screen) Reset() {
@@ -424,6 +457,153 @@ func (x *Screen) GetIndex() int32 {
 	return 0
 }
 
+func (x *Screen) GetObserver() bool {
+	if x != nil {
+		return x.Observer
+	}
+	return false
+}
+
+func (x *Screen) GetClockOffsetNS() int64 {
+	if x != nil {
+		return x.ClockOffsetNS
+	}
+	return 0
+}
+
+func (x *Screen) GetClockRoundTripNS() int64 {
+	if x != nil {
+		return x.ClockRoundTripNS
+	}
+	return 0
+}
+
+func (x *Screen) GetBezelLeft() int32 {
+	if x != nil {
+		return x.BezelLeft
+	}
+	return 0
+}
+
+func (x *Screen) GetBezelRight() int32 {
+	if x != nil {
+		return x.BezelRight
+	}
+	return 0
+}
+
+func (x *Screen) GetGap() int32 {
+	if x != nil {
+		return x.Gap
+	}
+	return 0
+}
+
+func (x *Screen) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *Screen) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+func (x *Screen) GetSendStats() *SendStats {
+	if x != nil {
+		return x.SendStats
+	}
+	return nil
+}
+
+func (x *Screen) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+// SendStats counts the updates the leader has for a screen. Price updates of the same ticker are
+// conflated, only the newest is sent, and the oldest price updates are dropped when too many are
+// waiting. Other updates are never dropped, screens which fall too far behind are disconnected.
+type SendStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Updates waiting to be sent.
+	Queued int64 `protobuf:"varint,1,opt,name=Queued,proto3" json:"Queued,omitempty"`
+	Sent   int64 `protobuf:"varint,2,opt,name=Sent,proto3" json:"Sent,omitempty"`
+	// Price updates replaced by a newer price update of the same ticker before they were sent.
+	Conflated int64 `protobuf:"varint,3,opt,name=Conflated,proto3" json:"Conflated,omitempty"`
+	// Price updates dropped because too many were waiting.
+	Dropped int64 `protobuf:"varint,4,opt,name=Dropped,proto3" json:"Dropped,omitempty"`
+}
+
+func (x *SendStats) Reset() {
+	*x = SendStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStats) ProtoMessage() {}
+
+func (x *SendStats) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStats.ProtoReflect.Descriptor instead.
+func (*SendStats) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendStats) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *SendStats) GetSent() int64 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *SendStats) GetConflated() int64 {
+	if x != nil {
+		return x.Conflated
+	}
+	return 0
+}
+
+func (x *SendStats) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
 // ScreenCluster contains information about the whole screen cluster.
 type ScreenCluster struct {
 	state         protoimpl.MessageState
@@ -432,12 +612,15 @@ type ScreenCluster struct {
 
 	Settings *PresentationSettings `protobuf:"bytes,1,opt,name=Settings,proto3" json:"Settings,omitempty"`
 	Screens  []*Screen             `protobuf:"bytes,2,rep,name=Screens,proto3" json:"Screens,omitempty"`
+	Wall     string                `protobuf:"bytes,3,opt,name=Wall,proto3" json:"Wall,omitempty"`
+	// Screens disconnected because they fell too far behind, since the leader started.
+	SlowScreensDisconnected int64 `protobuf:"varint,4,opt,name=SlowScreensDisconnected,proto3" json:"SlowScreensDisconnected,omitempty"`
 }
 
 func (x *ScreenCluster) Reset() {
 	*x = ScreenCluster{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[5]
+		mi := &file_models_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +633,7 @@ func (x *ScreenCluster) String() string {
 func (*ScreenCluster) ProtoMessage() {}
 
 func (x *ScreenCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[5]
+	mi := &file_models_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +646,7 @@ func (x *ScreenCluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScreenCluster.ProtoReflect.Descriptor instead.
 func (*ScreenCluster) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{5}
+	return file_models_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ScreenCluster) GetSettings() *PresentationSettings {
@@ -480,6 +663,20 @@ func (x *ScreenCluster) GetScreens() []*Screen {
 	return nil
 }
 
+func (x *ScreenCluster) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+func (x *ScreenCluster) GetSlowScreensDisconnected() int64 {
+	if x != nil {
+		return x.SlowScreensDisconnected
+	}
+	return 0
+}
+
 type PresentationSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -496,12 +693,24 @@ type PresentationSettings struct {
 	ShowFPS             bool  `protobuf:"varint,9,opt,name=ShowFPS,proto3" json:"ShowFPS,omitempty"`
 	AnimationDurationMS int32 `protobuf:"varint,10,opt,name=AnimationDurationMS,proto3" json:"AnimationDurationMS,omitempty"`
 	PerTickUpdates      bool  `protobuf:"varint,11,opt,name=PerTickUpdates,proto3" json:"PerTickUpdates,omitempty"`
+	// How long it takes to ease into a new scroll speed, in milliseconds. 0 changes speed instantly.
+	ScrollEaseDurationMS int32 `protobuf:"varint,12,opt,name=ScrollEaseDurationMS,proto3" json:"ScrollEaseDurationMS,omitempty"`
+	// ScrollEpoch is managed by the leader, it cannot be updated directly.
+	ScrollEpoch *ScrollEpoch `protobuf:"bytes,13,opt,name=ScrollEpoch,proto3" json:"ScrollEpoch,omitempty"`
+	// Size each ticker box to fit it's content, TickerBoxWidth is then the minimum width.
+	DynamicTickerWidths bool `protobuf:"varint,14,opt,name=DynamicTickerWidths,proto3" json:"DynamicTickerWidths,omitempty"`
+	// TickerLayout is managed by the leader, it cannot be updated directly.
+	TickerLayout *TickerLayout `protobuf:"bytes,15,opt,name=TickerLayout,proto3" json:"TickerLayout,omitempty"`
+	// Width of the spacer card between repetitions of the tape, 0 for no spacer.
+	SpacerWidth int32 `protobuf:"varint,16,opt,name=SpacerWidth,proto3" json:"SpacerWidth,omitempty"`
+	// Text shown on the spacer card, such as a logo or company name.
+	SpacerText string `protobuf:"bytes,17,opt,name=SpacerText,proto3" json:"SpacerText,omitempty"`
 }
 
 func (x *PresentationSettings) Reset() {
 	*x = PresentationSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[6]
+		mi := &file_models_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -514,7 +723,7 @@ func (x *PresentationSettings) String() string {
 func (*PresentationSettings) ProtoMessage() {}
 
 func (x *PresentationSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[6]
+	mi := &file_models_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +736,7 @@ func (x *PresentationSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PresentationSettings.ProtoReflect.Descriptor instead.
 func (*PresentationSettings) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{6}
+	return file_models_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PresentationSettings) GetTickerBoxWidth() int32 {
@@ -607,108 +816,67 @@ func (x *PresentationSettings) GetPerTickUpdates() bool {
 	return false
 }
 
-// Update encapsulates different update messages.
-type Update struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UpdateType           int32                 `protobuf:"varint,1,opt,name=UpdateType,proto3" json:"UpdateType,omitempty"`
-	PriceUpdate          *PriceUpdate          `protobuf:"bytes,2,opt,name=PriceUpdate,proto3" json:"PriceUpdate,omitempty"`
-	Announcement         *Announcement         `protobuf:"bytes,3,opt,name=Announcement,proto3" json:"Announcement,omitempty"`
-	ScreenCluster        *ScreenCluster        `protobuf:"bytes,4,opt,name=ScreenCluster,proto3" json:"ScreenCluster,omitempty"`
-	Ticker               *Ticker               `protobuf:"bytes,5,opt,name=Ticker,proto3" json:"Ticker,omitempty"`
-	PresentationSettings *PresentationSettings `protobuf:"bytes,6,opt,name=PresentationSettings,proto3" json:"PresentationSettings,omitempty"`
-}
-
-func (x *Update) Reset() {
-	*x = Update{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_models_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Update) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Update) ProtoMessage() {}
-
-func (x *Update) ProtoReflect() protoreflect.Message {
-	mi := &file_models_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Update.ProtoReflect.Descriptor instead.
-func (*Update) Descriptor() ([]byte, []int) {
-	return file_models_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *Update) GetUpdateType() int32 {
+func (x *PresentationSettings) GetScrollEaseDurationMS() int32 {
 	if x != nil {
-		return x.UpdateType
+		return x.ScrollEaseDurationMS
 	}
 	return 0
 }
 
-func (x *Update) GetPriceUpdate() *PriceUpdate {
+func (x *PresentationSettings) GetScrollEpoch() *ScrollEpoch {
 	if x != nil {
-		return x.PriceUpdate
+		return x.ScrollEpoch
 	}
 	return nil
 }
 
-func (x *Update) GetAnnouncement() *Announcement {
+func (x *PresentationSettings) GetDynamicTickerWidths() bool {
 	if x != nil {
-		return x.Announcement
+		return x.DynamicTickerWidths
 	}
-	return nil
+	return false
 }
 
-func (x *Update) GetScreenCluster() *ScreenCluster {
+func (x *PresentationSettings) GetTickerLayout() *TickerLayout {
 	if x != nil {
-		return x.ScreenCluster
+		return x.TickerLayout
 	}
 	return nil
 }
 
-func (x *Update) GetTicker() *Ticker {
+func (x *PresentationSettings) GetSpacerWidth() int32 {
 	if x != nil {
-		return x.Ticker
+		return x.SpacerWidth
 	}
-	return nil
+	return 0
 }
 
-func (x *Update) GetPresentationSettings() *PresentationSettings {
+func (x *PresentationSettings) GetSpacerText() string {
 	if x != nil {
-		return x.PresentationSettings
+		return x.SpacerText
 	}
-	return nil
+	return ""
 }
 
-// RGBA is how we represent colors.
-type RGBA struct {
+// ScrollEpoch anchors the scroll position of the ticker tape. The leader sets a new epoch whenever
+// the scroll speed or tape changes, so every screen continues scrolling from where it was.
+type ScrollEpoch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Red   int32 `protobuf:"varint,1,opt,name=Red,proto3" json:"Red,omitempty"`
-	Green int32 `protobuf:"varint,2,opt,name=Green,proto3" json:"Green,omitempty"`
-	Blue  int32 `protobuf:"varint,3,opt,name=Blue,proto3" json:"Blue,omitempty"`
-	Alpha int32 `protobuf:"varint,4,opt,name=Alpha,proto3" json:"Alpha,omitempty"`
+	// Leader time the epoch starts at.
+	TimestampMS int64 `protobuf:"varint,1,opt,name=TimestampMS,proto3" json:"TimestampMS,omitempty"`
+	// Tape offset, in pixels, at the start of the epoch.
+	Offset float64 `protobuf:"fixed64,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	// Scroll velocity, in pixels per millisecond, at the start of the epoch. The tape eases from
+	// this velocity into the current scroll speed over EaseDurationMS.
+	StartVelocity  float64 `protobuf:"fixed64,3,opt,name=StartVelocity,proto3" json:"StartVelocity,omitempty"`
+	EaseDurationMS int32   `protobuf:"varint,4,opt,name=EaseDurationMS,proto3" json:"EaseDurationMS,omitempty"`
 }
 
-func (x *RGBA) Reset() {
-	*x = RGBA{}
+func (x *ScrollEpoch) Reset() {
+	*x = ScrollEpoch{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,13 +884,13 @@ func (x *RGBA) Reset() {
 	}
 }
 
-func (x *RGBA) String() string {
+func (x *ScrollEpoch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RGBA) ProtoMessage() {}
+func (*ScrollEpoch) ProtoMessage() {}
 
-func (x *RGBA) ProtoReflect() protoreflect.Message {
+func (x *ScrollEpoch) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -734,50 +902,52 @@ func (x *RGBA) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RGBA.ProtoReflect.Descriptor instead.
-func (*RGBA) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScrollEpoch.ProtoReflect.Descriptor instead.
+func (*ScrollEpoch) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RGBA) GetRed() int32 {
+func (x *ScrollEpoch) GetTimestampMS() int64 {
 	if x != nil {
-		return x.Red
+		return x.TimestampMS
 	}
 	return 0
 }
 
-func (x *RGBA) GetGreen() int32 {
+func (x *ScrollEpoch) GetOffset() float64 {
 	if x != nil {
-		return x.Green
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *RGBA) GetBlue() int32 {
+func (x *ScrollEpoch) GetStartVelocity() float64 {
 	if x != nil {
-		return x.Blue
+		return x.StartVelocity
 	}
 	return 0
 }
 
-func (x *RGBA) GetAlpha() int32 {
+func (x *ScrollEpoch) GetEaseDurationMS() int32 {
 	if x != nil {
-		return x.Alpha
+		return x.EaseDurationMS
 	}
 	return 0
 }
 
-// Group of Tickers
-type Tickers struct {
+// TickerLayout positions each ticker box on the tape, so every screen agrees on where the tickers
+// are. Boxes are in the order they are displayed.
+type TickerLayout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tickers []*Ticker `protobuf:"bytes,1,rep,name=Tickers,proto3" json:"Tickers,omitempty"`
+	Boxes     []*TickerBox `protobuf:"bytes,1,rep,name=Boxes,proto3" json:"Boxes,omitempty"`
+	TapeWidth int32        `protobuf:"varint,2,opt,name=TapeWidth,proto3" json:"TapeWidth,omitempty"`
 }
 
-func (x *Tickers) Reset() {
-	*x = Tickers{}
+func (x *TickerLayout) Reset() {
+	*x = TickerLayout{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,13 +955,13 @@ func (x *Tickers) Reset() {
 	}
 }
 
-func (x *Tickers) String() string {
+func (x *TickerLayout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tickers) ProtoMessage() {}
+func (*TickerLayout) ProtoMessage() {}
 
-func (x *Tickers) ProtoReflect() protoreflect.Message {
+func (x *TickerLayout) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -803,26 +973,38 @@ func (x *Tickers) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tickers.ProtoReflect.Descriptor instead.
-func (*Tickers) Descriptor() ([]byte, []int) {
+// Deprecated: Use TickerLayout.ProtoReflect.Descriptor instead.
+func (*TickerLayout) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Tickers) GetTickers() []*Ticker {
+func (x *TickerLayout) GetBoxes() []*TickerBox {
 	if x != nil {
-		return x.Tickers
+		return x.Boxes
 	}
 	return nil
 }
 
-type Empty struct {
+func (x *TickerLayout) GetTapeWidth() int32 {
+	if x != nil {
+		return x.TapeWidth
+	}
+	return 0
+}
+
+// TickerBox is the position of a single ticker on the tape, in pixels.
+type TickerBox struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ticker string `protobuf:"bytes,1,opt,name=Ticker,proto3" json:"Ticker,omitempty"`
+	Offset int32  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Width  int32  `protobuf:"varint,3,opt,name=Width,proto3" json:"Width,omitempty"`
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *TickerBox) Reset() {
+	*x = TickerBox{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_models_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -830,13 +1012,13 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *TickerBox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*TickerBox) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *TickerBox) ProtoReflect() protoreflect.Message {
 	mi := &file_models_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,166 +1030,1947 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use TickerBox.ProtoReflect.Descriptor instead.
+func (*TickerBox) Descriptor() ([]byte, []int) {
 	return file_models_proto_rawDescGZIP(), []int{10}
 }
 
-var File_models_proto protoreflect.FileDescriptor
+func (x *TickerBox) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
 
-var file_models_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x4f,
-	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x12, 0x34, 0x0a,
-	0x15, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x12, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x6d, 0x67,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x49, 0x6d, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x49,
-	0x6d, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x49, 0x6d,
-	0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x04, 0x41, 0x67, 0x67, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x67, 0x67,
-	0x52, 0x04, 0x41, 0x67, 0x67, 0x73, 0x22, 0x51, 0x0a, 0x03, 0x41, 0x67, 0x67, 0x12, 0x14, 0x0a,
-	0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3b, 0x0a, 0x0b, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
-	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a,
-	0x11, 0x53, 0x68, 0x6f, 0x77, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x4d, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x53, 0x68, 0x6f, 0x77, 0x41, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
-	0x69, 0x66, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x4d, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x4d, 0x53, 0x12, 0x1c, 0x0a, 0x09, 0x41,
-	0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x06, 0x53, 0x63, 0x72,
-	0x65, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a,
-	0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x73, 0x0a, 0x0d, 0x53,
-	0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x08,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x52, 0x07, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73,
-	0x22, 0xd4, 0x03, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x57, 0x69, 0x64, 0x74,
-	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x70, 0x65, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x70,
-	0x65, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x55, 0x70, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x47,
-	0x42, 0x41, 0x52, 0x07, 0x55, 0x70, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x44,
-	0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x09, 0x44, 0x6f,
-	0x77, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x07, 0x42, 0x47, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x07, 0x42, 0x47, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
-	0x2a, 0x0a, 0x09, 0x46, 0x6f, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x47, 0x42, 0x41,
-	0x52, 0x09, 0x46, 0x6f, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x10, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x42, 0x47, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52,
-	0x47, 0x42, 0x41, 0x52, 0x10, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x42, 0x47,
-	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x6f, 0x67,
-	0x6f, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x6f,
-	0x67, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x68, 0x6f, 0x77, 0x46, 0x50, 0x53, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x68, 0x6f, 0x77, 0x46, 0x50, 0x53, 0x12, 0x30, 0x0a,
-	0x13, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x53, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x41, 0x6e, 0x69, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x53, 0x12,
-	0x26, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x50, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xd0, 0x02, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x41, 0x6e, 0x6e,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x12, 0x26, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
-	0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73,
-	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x52, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x58, 0x0a, 0x04, 0x52, 0x47,
-	0x42, 0x41, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x52, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x42, 0x6c,
-	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x42, 0x6c, 0x75, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x41,
-	0x6c, 0x70, 0x68, 0x61, 0x22, 0x33, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12,
-	0x28, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
-	0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x32, 0xef, 0x02, 0x0a, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a,
-	0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x1a, 0x0e, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x12, 0x2e, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x0d,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x00,
-	0x12, 0x5a, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x1c, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x08,
-	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x14,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72,
-	0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x22, 0x00, 0x12, 0x30, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x12, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x1a, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65,
-	0x65, 0x6e, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6f,
-	0x2d, 0x61, 0x70, 0x70, 0x2d, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x77, 0x61, 0x6c, 0x6c, 0x2f,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *TickerBox) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-var (
-	file_models_proto_rawDescOnce sync.Once
-	file_models_proto_rawDescData = file_models_proto_rawDesc
-)
+func (x *TickerBox) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+// UpdatePresentationSettingsRequest is a partial update of the presentation settings. The update
+// mask lists the fields to change ( eg: "ScrollSpeed", "UpColor.Red" ), an empty mask replaces
+// all of the settings.
+type UpdatePresentationSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PresentationSettings *PresentationSettings  `protobuf:"bytes,1,opt,name=PresentationSettings,proto3" json:"PresentationSettings,omitempty"`
+	UpdateMask           *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=UpdateMask,proto3" json:"UpdateMask,omitempty"`
+	Wall                 string                 `protobuf:"bytes,3,opt,name=Wall,proto3" json:"Wall,omitempty"`
+}
+
+func (x *UpdatePresentationSettingsRequest) Reset() {
+	*x = UpdatePresentationSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePresentationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePresentationSettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePresentationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePresentationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePresentationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdatePresentationSettingsRequest) GetPresentationSettings() *PresentationSettings {
+	if x != nil {
+		return x.PresentationSettings
+	}
+	return nil
+}
+
+func (x *UpdatePresentationSettingsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePresentationSettingsRequest) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+// Update encapsulates different update messages.
+type Update struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdateType           int32                 `protobuf:"varint,1,opt,name=UpdateType,proto3" json:"UpdateType,omitempty"`
+	PriceUpdate          *PriceUpdate          `protobuf:"bytes,2,opt,name=PriceUpdate,proto3" json:"PriceUpdate,omitempty"`
+	Announcement         *Announcement         `protobuf:"bytes,3,opt,name=Announcement,proto3" json:"Announcement,omitempty"`
+	ScreenCluster        *ScreenCluster        `protobuf:"bytes,4,opt,name=ScreenCluster,proto3" json:"ScreenCluster,omitempty"`
+	Ticker               *Ticker               `protobuf:"bytes,5,opt,name=Ticker,proto3" json:"Ticker,omitempty"`
+	PresentationSettings *PresentationSettings `protobuf:"bytes,6,opt,name=PresentationSettings,proto3" json:"PresentationSettings,omitempty"`
+	// Wall the update is for, empty updates are for every wall.
+	Wall string `protobuf:"bytes,7,opt,name=Wall,proto3" json:"Wall,omitempty"`
+	// Sequence numbers the updates of a wall, each is one more than the last. Price updates aren't
+	// numbered, they are 0.
+	Sequence int64     `protobuf:"varint,8,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	Snapshot *Snapshot `protobuf:"bytes,9,opt,name=Snapshot,proto3" json:"Snapshot,omitempty"`
+}
+
+func (x *Update) Reset() {
+	*x = Update{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Update) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Update) ProtoMessage() {}
+
+func (x *Update) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Update.ProtoReflect.Descriptor instead.
+func (*Update) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Update) GetUpdateType() int32 {
+	if x != nil {
+		return x.UpdateType
+	}
+	return 0
+}
+
+func (x *Update) GetPriceUpdate() *PriceUpdate {
+	if x != nil {
+		return x.PriceUpdate
+	}
+	return nil
+}
+
+func (x *Update) GetAnnouncement() *Announcement {
+	if x != nil {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *Update) GetScreenCluster() *ScreenCluster {
+	if x != nil {
+		return x.ScreenCluster
+	}
+	return nil
+}
+
+func (x *Update) GetTicker() *Ticker {
+	if x != nil {
+		return x.Ticker
+	}
+	return nil
+}
+
+func (x *Update) GetPresentationSettings() *PresentationSettings {
+	if x != nil {
+		return x.PresentationSettings
+	}
+	return nil
+}
+
+func (x *Update) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+func (x *Update) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Update) GetSnapshot() *Snapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// Snapshot is the full state of a wall, including every update up to and including it's sequence.
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wall          string          `protobuf:"bytes,1,opt,name=Wall,proto3" json:"Wall,omitempty"`
+	Sequence      int64           `protobuf:"varint,2,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	ScreenCluster *ScreenCluster  `protobuf:"bytes,3,opt,name=ScreenCluster,proto3" json:"ScreenCluster,omitempty"`
+	Tickers       []*Ticker       `protobuf:"bytes,4,rep,name=Tickers,proto3" json:"Tickers,omitempty"`
+	Announcements []*Announcement `protobuf:"bytes,5,rep,name=Announcements,proto3" json:"Announcements,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Snapshot) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+func (x *Snapshot) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Snapshot) GetScreenCluster() *ScreenCluster {
+	if x != nil {
+		return x.ScreenCluster
+	}
+	return nil
+}
+
+func (x *Snapshot) GetTickers() []*Ticker {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+func (x *Snapshot) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+// RGBA is how we represent colors.
+type RGBA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Red   int32 `protobuf:"varint,1,opt,name=Red,proto3" json:"Red,omitempty"`
+	Green int32 `protobuf:"varint,2,opt,name=Green,proto3" json:"Green,omitempty"`
+	Blue  int32 `protobuf:"varint,3,opt,name=Blue,proto3" json:"Blue,omitempty"`
+	Alpha int32 `protobuf:"varint,4,opt,name=Alpha,proto3" json:"Alpha,omitempty"`
+}
+
+func (x *RGBA) Reset() {
+	*x = RGBA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RGBA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RGBA) ProtoMessage() {}
+
+func (x *RGBA) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RGBA.ProtoReflect.Descriptor instead.
+func (*RGBA) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RGBA) GetRed() int32 {
+	if x != nil {
+		return x.Red
+	}
+	return 0
+}
+
+func (x *RGBA) GetGreen() int32 {
+	if x != nil {
+		return x.Green
+	}
+	return 0
+}
+
+func (x *RGBA) GetBlue() int32 {
+	if x != nil {
+		return x.Blue
+	}
+	return 0
+}
+
+func (x *RGBA) GetAlpha() int32 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+// TickerRequest selects a ticker to add or remove.
+type TickerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticker string `protobuf:"bytes,1,opt,name=Ticker,proto3" json:"Ticker,omitempty"`
+	Wall   string `protobuf:"bytes,2,opt,name=Wall,proto3" json:"Wall,omitempty"`
+}
+
+func (x *TickerRequest) Reset() {
+	*x = TickerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TickerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TickerRequest) ProtoMessage() {}
+
+func (x *TickerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TickerRequest.ProtoReflect.Descriptor instead.
+func (*TickerRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TickerRequest) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *TickerRequest) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+// WallRequest selects a wall. Empty is the default wall.
+type WallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wall string `protobuf:"bytes,1,opt,name=Wall,proto3" json:"Wall,omitempty"`
+}
+
+func (x *WallRequest) Reset() {
+	*x = WallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WallRequest) ProtoMessage() {}
+
+func (x *WallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WallRequest.ProtoReflect.Descriptor instead.
+func (*WallRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WallRequest) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+// Walls is the list of wall names.
+type Walls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Walls []string `protobuf:"bytes,1,rep,name=Walls,proto3" json:"Walls,omitempty"`
+}
+
+func (x *Walls) Reset() {
+	*x = Walls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Walls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Walls) ProtoMessage() {}
+
+func (x *Walls) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Walls.ProtoReflect.Descriptor instead.
+func (*Walls) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Walls) GetWalls() []string {
+	if x != nil {
+		return x.Walls
+	}
+	return nil
+}
+
+// ClockSyncRequest is sent by a screen to measure it's clock offset from the leader. The screen
+// also reports it's last measurement, so the leader can display it.
+type ClockSyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScreenUUID       string `protobuf:"bytes,1,opt,name=ScreenUUID,proto3" json:"ScreenUUID,omitempty"`
+	ClientTransmitNS int64  `protobuf:"varint,2,opt,name=ClientTransmitNS,proto3" json:"ClientTransmitNS,omitempty"`
+	ClockOffsetNS    int64  `protobuf:"varint,3,opt,name=ClockOffsetNS,proto3" json:"ClockOffsetNS,omitempty"`
+	ClockRoundTripNS int64  `protobuf:"varint,4,opt,name=ClockRoundTripNS,proto3" json:"ClockRoundTripNS,omitempty"`
+}
+
+func (x *ClockSyncRequest) Reset() {
+	*x = ClockSyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClockSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockSyncRequest) ProtoMessage() {}
+
+func (x *ClockSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockSyncRequest.ProtoReflect.Descriptor instead.
+func (*ClockSyncRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ClockSyncRequest) GetScreenUUID() string {
+	if x != nil {
+		return x.ScreenUUID
+	}
+	return ""
+}
+
+func (x *ClockSyncRequest) GetClientTransmitNS() int64 {
+	if x != nil {
+		return x.ClientTransmitNS
+	}
+	return 0
+}
+
+func (x *ClockSyncRequest) GetClockOffsetNS() int64 {
+	if x != nil {
+		return x.ClockOffsetNS
+	}
+	return 0
+}
+
+func (x *ClockSyncRequest) GetClockRoundTripNS() int64 {
+	if x != nil {
+		return x.ClockRoundTripNS
+	}
+	return 0
+}
+
+// ClockSyncResponse contains the leaders timestamps for a clock sync request.
+type ClockSyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientTransmitNS int64 `protobuf:"varint,1,opt,name=ClientTransmitNS,proto3" json:"ClientTransmitNS,omitempty"`
+	LeaderReceiveNS  int64 `protobuf:"varint,2,opt,name=LeaderReceiveNS,proto3" json:"LeaderReceiveNS,omitempty"`
+	LeaderTransmitNS int64 `protobuf:"varint,3,opt,name=LeaderTransmitNS,proto3" json:"LeaderTransmitNS,omitempty"`
+}
+
+func (x *ClockSyncResponse) Reset() {
+	*x = ClockSyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClockSyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockSyncResponse) ProtoMessage() {}
+
+func (x *ClockSyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockSyncResponse.ProtoReflect.Descriptor instead.
+func (*ClockSyncResponse) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ClockSyncResponse) GetClientTransmitNS() int64 {
+	if x != nil {
+		return x.ClientTransmitNS
+	}
+	return 0
+}
+
+func (x *ClockSyncResponse) GetLeaderReceiveNS() int64 {
+	if x != nil {
+		return x.LeaderReceiveNS
+	}
+	return 0
+}
+
+func (x *ClockSyncResponse) GetLeaderTransmitNS() int64 {
+	if x != nil {
+		return x.LeaderTransmitNS
+	}
+	return 0
+}
+
+// Group of Tickers
+type Tickers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickers []*Ticker `protobuf:"bytes,1,rep,name=Tickers,proto3" json:"Tickers,omitempty"`
+}
+
+func (x *Tickers) Reset() {
+	*x = Tickers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tickers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tickers) ProtoMessage() {}
+
+func (x *Tickers) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tickers.ProtoReflect.Descriptor instead.
+func (*Tickers) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Tickers) GetTickers() []*Ticker {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+// Group of Announcements
+type Announcements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Announcements []*Announcement `protobuf:"bytes,1,rep,name=Announcements,proto3" json:"Announcements,omitempty"`
+}
+
+func (x *Announcements) Reset() {
+	*x = Announcements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Announcements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Announcements) ProtoMessage() {}
+
+func (x *Announcements) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Announcements.ProtoReflect.Descriptor instead.
+func (*Announcements) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Announcements) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+// DataSourceHealth is how the leaders market data source is doing. Timestamps are unix
+// milliseconds, and 0 when it hasn't happened yet.
+type DataSourceHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source is where market data comes from ( polygon, sim, replay or custom ).
+	Source string `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
+	// Streaming is true while the price update stream is running.
+	Streaming bool  `protobuf:"varint,2,opt,name=Streaming,proto3" json:"Streaming,omitempty"`
+	StartedMS int64 `protobuf:"varint,3,opt,name=StartedMS,proto3" json:"StartedMS,omitempty"`
+	// Number of price updates received, and when the last one was received.
+	PriceUpdates      int64 `protobuf:"varint,4,opt,name=PriceUpdates,proto3" json:"PriceUpdates,omitempty"`
+	LastPriceUpdateMS int64 `protobuf:"varint,5,opt,name=LastPriceUpdateMS,proto3" json:"LastPriceUpdateMS,omitempty"`
+	// When the aggregates and details of the tickers were last refreshed.
+	LastAggsRefreshMS    int64 `protobuf:"varint,6,opt,name=LastAggsRefreshMS,proto3" json:"LastAggsRefreshMS,omitempty"`
+	LastDetailsRefreshMS int64 `protobuf:"varint,7,opt,name=LastDetailsRefreshMS,proto3" json:"LastDetailsRefreshMS,omitempty"`
+	// The last error from the data source, and when it happened.
+	LastError   string `protobuf:"bytes,8,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	LastErrorMS int64  `protobuf:"varint,9,opt,name=LastErrorMS,proto3" json:"LastErrorMS,omitempty"`
+}
+
+func (x *DataSourceHealth) Reset() {
+	*x = DataSourceHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataSourceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataSourceHealth) ProtoMessage() {}
+
+func (x *DataSourceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataSourceHealth.ProtoReflect.Descriptor instead.
+func (*DataSourceHealth) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DataSourceHealth) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DataSourceHealth) GetStreaming() bool {
+	if x != nil {
+		return x.Streaming
+	}
+	return false
+}
+
+func (x *DataSourceHealth) GetStartedMS() int64 {
+	if x != nil {
+		return x.StartedMS
+	}
+	return 0
+}
+
+func (x *DataSourceHealth) GetPriceUpdates() int64 {
+	if x != nil {
+		return x.PriceUpdates
+	}
+	return 0
+}
+
+func (x *DataSourceHealth) GetLastPriceUpdateMS() int64 {
+	if x != nil {
+		return x.LastPriceUpdateMS
+	}
+	return 0
+}
+
+func (x *DataSourceHealth) GetLastAggsRefreshMS() int64 {
+	if x != nil {
+		return x.LastAggsRefreshMS
+	}
+	return 0
+}
+
+func (x *DataSourceHealth) GetLastDetailsRefreshMS() int64 {
+	if x != nil {
+		return x.LastDetailsRefreshMS
+	}
+	return 0
+}
+
+func (x *DataSourceHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DataSourceHealth) GetLastErrorMS() int64 {
+	if x != nil {
+		return x.LastErrorMS
+	}
+	return 0
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{23}
+}
+
+// AccessControl is who can use the leader, and what they can do. Each RPC is a permission.
+type AccessControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Enabled is false when the leader has no token or users, and everyone can do everything.
+	Enabled bool    `protobuf:"varint,1,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Roles   []*Role `protobuf:"bytes,2,rep,name=Roles,proto3" json:"Roles,omitempty"`
+	Users   []*User `protobuf:"bytes,3,rep,name=Users,proto3" json:"Users,omitempty"`
+	// DefaultRole is the role of requests without a token, empty when they are rejected.
+	DefaultRole string `protobuf:"bytes,4,opt,name=DefaultRole,proto3" json:"DefaultRole,omitempty"`
+}
+
+func (x *AccessControl) Reset() {
+	*x = AccessControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessControl) ProtoMessage() {}
+
+func (x *AccessControl) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessControl.ProtoReflect.Descriptor instead.
+func (*AccessControl) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AccessControl) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AccessControl) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AccessControl) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AccessControl) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The RPCs the role can use, including the ones of the roles it includes.
+	Permissions []string `protobuf:"bytes,2,rep,name=Permissions,proto3" json:"Permissions,omitempty"`
+	// BuiltIn roles are always there, the others are from the config file.
+	BuiltIn bool `protobuf:"varint,3,opt,name=BuiltIn,proto3" json:"BuiltIn,omitempty"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+// User is someone who can sign in to the leader, with a token or a client certificate.
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Role string `protobuf:"bytes,2,opt,name=Role,proto3" json:"Role,omitempty"`
+	// Token is true when the user signs in with a token.
+	Token bool `protobuf:"varint,3,opt,name=Token,proto3" json:"Token,omitempty"`
+	// Certificate is the common name of the users client certificate, empty when they don't use one.
+	Certificate string `protobuf:"bytes,4,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetToken() bool {
+	if x != nil {
+		return x.Token
+	}
+	return false
+}
+
+func (x *User) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+// AuditLogRequest filters the audit log. Empty filters match everything.
+type AuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Wall to get the changes of, every wall when empty.
+	Wall string `protobuf:"bytes,1,opt,name=Wall,proto3" json:"Wall,omitempty"`
+	// Action is the RPC which made the change, eg: Announce
+	Action string `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	User   string `protobuf:"bytes,3,opt,name=User,proto3" json:"User,omitempty"`
+	// Limit is how many of the most recent entries to return, 100 when 0.
+	Limit int32 `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *AuditLogRequest) Reset() {
+	*x = AuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogRequest) ProtoMessage() {}
+
+func (x *AuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogRequest.ProtoReflect.Descriptor instead.
+func (*AuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AuditLogRequest) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+func (x *AuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// AuditLog is a list of audit entries, oldest first.
+type AuditLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AuditLog) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// AuditEntry is a change made to a wall: who made it, when, and the values before and after.
+type AuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimestampMS int64 `protobuf:"varint,1,opt,name=TimestampMS,proto3" json:"TimestampMS,omitempty"`
+	// Who made the change, and their role. Empty when access control is off.
+	User string `protobuf:"bytes,2,opt,name=User,proto3" json:"User,omitempty"`
+	Role string `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	// Action is the RPC which made the change, eg: UpdatePresentationSettings
+	Action string `protobuf:"bytes,4,opt,name=Action,proto3" json:"Action,omitempty"`
+	Wall   string `protobuf:"bytes,5,opt,name=Wall,proto3" json:"Wall,omitempty"`
+	// Target is what was changed, eg: a ticker or a screens UUID. Empty for presentation settings.
+	Target string `protobuf:"bytes,6,opt,name=Target,proto3" json:"Target,omitempty"`
+	// The value before and after the change, unset when there wasn't one ( eg: an added ticker ).
+	Before *anypb.Any `protobuf:"bytes,7,opt,name=Before,proto3" json:"Before,omitempty"`
+	After  *anypb.Any `protobuf:"bytes,8,opt,name=After,proto3" json:"After,omitempty"`
+	// Changed are the fields which are different between before and after.
+	Changed []string `protobuf:"bytes,9,rep,name=Changed,proto3" json:"Changed,omitempty"`
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AuditEntry) GetTimestampMS() int64 {
+	if x != nil {
+		return x.TimestampMS
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetBefore() *anypb.Any {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetAfter() *anypb.Any {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+// LeaderState is the part of the leaders state which is persisted to disk, so it survives restarts.
+// The top level fields are from before there were multiple walls, they are restored as the default
+// wall.
+type LeaderState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PresentationSettings *PresentationSettings `protobuf:"bytes,1,opt,name=PresentationSettings,proto3" json:"PresentationSettings,omitempty"`
+	Tickers              []string              `protobuf:"bytes,2,rep,name=Tickers,proto3" json:"Tickers,omitempty"`
+	Announcements        []*Announcement       `protobuf:"bytes,3,rep,name=Announcements,proto3" json:"Announcements,omitempty"`
+	Walls                []*WallState          `protobuf:"bytes,4,rep,name=Walls,proto3" json:"Walls,omitempty"`
+}
+
+func (x *LeaderState) Reset() {
+	*x = LeaderState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderState) ProtoMessage() {}
+
+func (x *LeaderState) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderState.ProtoReflect.Descriptor instead.
+func (*LeaderState) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LeaderState) GetPresentationSettings() *PresentationSettings {
+	if x != nil {
+		return x.PresentationSettings
+	}
+	return nil
+}
+
+func (x *LeaderState) GetTickers() []string {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+func (x *LeaderState) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+func (x *LeaderState) GetWalls() []*WallState {
+	if x != nil {
+		return x.Walls
+	}
+	return nil
+}
+
+// WallState is the persisted state of a single wall.
+type WallState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string                `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	PresentationSettings *PresentationSettings `protobuf:"bytes,2,opt,name=PresentationSettings,proto3" json:"PresentationSettings,omitempty"`
+	Tickers              []string              `protobuf:"bytes,3,rep,name=Tickers,proto3" json:"Tickers,omitempty"`
+	Announcements        []*Announcement       `protobuf:"bytes,4,rep,name=Announcements,proto3" json:"Announcements,omitempty"`
+}
+
+func (x *WallState) Reset() {
+	*x = WallState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_models_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WallState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WallState) ProtoMessage() {}
+
+func (x *WallState) ProtoReflect() protoreflect.Message {
+	mi := &file_models_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WallState.ProtoReflect.Descriptor instead.
+func (*WallState) Descriptor() ([]byte, []int) {
+	return file_models_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WallState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WallState) GetPresentationSettings() *PresentationSettings {
+	if x != nil {
+		return x.PresentationSettings
+	}
+	return nil
+}
+
+func (x *WallState) GetTickers() []string {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+func (x *WallState) GetAnnouncements() []*Announcement {
+	if x != nil {
+		return x.Announcements
+	}
+	return nil
+}
+
+var File_models_proto protoreflect.FileDescriptor
+
+var file_models_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x4f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x43, 0x61, 0x70, 0x12, 0x34, 0x0a, 0x15, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x12, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x6d, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x49, 0x6d, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x6d, 0x67, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x49, 0x6d, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1f, 0x0a, 0x04, 0x41, 0x67, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x67, 0x67, 0x52, 0x04, 0x41,
+	0x67, 0x67, 0x73, 0x22, 0x51, 0x0a, 0x03, 0x41, 0x67, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x3b, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x53, 0x68,
+	0x6f, 0x77, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x53, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x53, 0x68, 0x6f, 0x77, 0x41, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x53, 0x12, 0x1e, 0x0a, 0x0a, 0x4c, 0x69, 0x66, 0x65,
+	0x73, 0x70, 0x61, 0x6e, 0x4d, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4c, 0x69,
+	0x66, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x4d, 0x53, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6e, 0x69, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x41, 0x6e, 0x69,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x22, 0x9f, 0x03, 0x0a, 0x06, 0x53,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x57, 0x69, 0x64,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a,
+	0x08, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4e, 0x53, 0x12,
+	0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69,
+	0x70, 0x4e, 0x53, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x4e, 0x53, 0x12, 0x1c, 0x0a, 0x09, 0x42,
+	0x65, 0x7a, 0x65, 0x6c, 0x4c, 0x65, 0x66, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x42, 0x65, 0x7a, 0x65, 0x6c, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x65, 0x7a,
+	0x65, 0x6c, 0x52, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x42,
+	0x65, 0x7a, 0x65, 0x6c, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x61, 0x70,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x47, 0x61, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x52,
+	0x6f, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a,
+	0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57, 0x61, 0x6c,
+	0x6c, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x09,
+	0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0xc1, 0x01,
+	0x0a, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x38, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x52, 0x07, 0x53, 0x63, 0x72, 0x65,
+	0x65, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x12, 0x38, 0x0a, 0x17, 0x53, 0x6c, 0x6f, 0x77, 0x53,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x53, 0x6c, 0x6f, 0x77, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x73, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x22, 0xed, 0x05, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x57, 0x69, 0x64,
+	0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x55, 0x70, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52,
+	0x47, 0x42, 0x41, 0x52, 0x07, 0x55, 0x70, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x09,
+	0x44, 0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x09, 0x44,
+	0x6f, 0x77, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x07, 0x42, 0x47, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x52, 0x47, 0x42, 0x41, 0x52, 0x07, 0x42, 0x47, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x2a, 0x0a, 0x09, 0x46, 0x6f, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x47, 0x42,
+	0x41, 0x52, 0x09, 0x46, 0x6f, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x10,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x42, 0x47, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x52, 0x47, 0x42, 0x41, 0x52, 0x10, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x42,
+	0x47, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x77, 0x4c, 0x6f,
+	0x67, 0x6f, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x53, 0x68, 0x6f, 0x77, 0x4c,
+	0x6f, 0x67, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x68, 0x6f, 0x77, 0x46, 0x50, 0x53, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x68, 0x6f, 0x77, 0x46, 0x50, 0x53, 0x12, 0x30,
+	0x0a, 0x13, 0x41, 0x6e, 0x69, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x53, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x41, 0x6e, 0x69,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x53,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x50, 0x65, 0x72, 0x54, 0x69, 0x63,
+	0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x53, 0x63, 0x72, 0x6f,
+	0x6c, 0x6c, 0x45, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x53,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x45, 0x61,
+	0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x53, 0x12, 0x35, 0x0a, 0x0b,
+	0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x6f, 0x6c,
+	0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x0b, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x13, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x57, 0x69, 0x64, 0x74, 0x68, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x57,
+	0x69, 0x64, 0x74, 0x68, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x4c,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x52, 0x0c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x72, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x72, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x70, 0x61, 0x63, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x70, 0x61, 0x63, 0x65, 0x72, 0x54, 0x65, 0x78,
+	0x74, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x53, 0x63, 0x72, 0x6f, 0x6c, 0x6c, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x53,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x4d, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x45, 0x61, 0x73, 0x65, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x53, 0x22, 0x55, 0x0a, 0x0c, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x42, 0x6f, 0x78,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x52, 0x05, 0x42, 0x6f, 0x78,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x61, 0x70, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x54, 0x61, 0x70, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68,
+	0x22, 0x51, 0x0a, 0x09, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x42, 0x6f, 0x78, 0x12, 0x16, 0x0a,
+	0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x57, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x57, 0x69,
+	0x64, 0x74, 0x68, 0x22, 0xc5, 0x01, 0x0a, 0x21, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x50, 0x0a, 0x14, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x22, 0xae, 0x03, 0x0a, 0x06,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a,
+	0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x14,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57, 0x61,
+	0x6c, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xdd, 0x01, 0x0a,
+	0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x53, 0x63, 0x72,
+	0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73,
+	0x12, 0x3a, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x58, 0x0a, 0x04,
+	0x52, 0x47, 0x42, 0x41, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x52, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x47, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x42, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x42, 0x6c, 0x75, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x22, 0x3b, 0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57,
+	0x61, 0x6c, 0x6c, 0x22, 0x21, 0x0a, 0x0b, 0x57, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x22, 0x1d, 0x0a, 0x05, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x57, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x4e, 0x53, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6d, 0x69, 0x74, 0x4e, 0x53, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4e, 0x53, 0x12, 0x2a, 0x0a, 0x10,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x4e, 0x53,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x4e, 0x53, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74,
+	0x4e, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x4e, 0x53, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4e, 0x53, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x4e, 0x53, 0x12, 0x2a, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x4e, 0x53,
+	0x22, 0x33, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x07, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x4b, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x4d, 0x53, 0x12, 0x22, 0x0a, 0x0c, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x53, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x4c, 0x61, 0x73, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x53, 0x12, 0x2c, 0x0a,
+	0x11, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x67, 0x67, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x4d, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x4c, 0x61, 0x73, 0x74, 0x41, 0x67,
+	0x67, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x53, 0x12, 0x32, 0x0a, 0x14, 0x4c,
+	0x61, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x4d, 0x53, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x4c, 0x61, 0x73, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4d, 0x53, 0x12,
+	0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a,
+	0x0b, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x53, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x53, 0x22,
+	0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x93, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x56,
+	0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x42, 0x75, 0x69, 0x6c, 0x74, 0x49, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x42,
+	0x75, 0x69, 0x6c, 0x74, 0x49, 0x6e, 0x22, 0x66, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x67,
+	0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x57, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x38, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x12, 0x2c, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x8e, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x53, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x4d, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x57, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2c,
+	0x0a, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x52, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x22, 0xde, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x50, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x14,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x3a,
+	0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x41, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x57, 0x61,
+	0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x57, 0x61,
+	0x6c, 0x6c, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x09, 0x57, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x32, 0xec, 0x08,
+	0x0a, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x1a, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x34, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22,
+	0x00, 0x12, 0x67, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x08, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x1a, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x15,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x2b, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x12,
+	0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x00, 0x12,
+	0x32, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x12, 0x13, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c,
+	0x73, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x61, 0x6c,
+	0x6c, 0x12, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0a,
+	0x4b, 0x69, 0x63, 0x6b, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x12, 0x0e, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x1a, 0x0e, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x12, 0x0d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x15, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x17, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x57, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x70, 0x2d, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x2d, 0x77, 0x61, 0x6c, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_models_proto_rawDescOnce sync.Once
+	file_models_proto_rawDescData = file_models_proto_rawDesc
+)
 
 func file_models_proto_rawDescGZIP() []byte {
 	file_models_proto_rawDescOnce.Do(func() {
@@ -1016,52 +2979,122 @@ func file_models_proto_rawDescGZIP() []byte {
 	return file_models_proto_rawDescData
 }
 
-var file_models_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_models_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_models_proto_goTypes = []interface{}{
-	(*Ticker)(nil),               // 0: models.Ticker
-	(*Agg)(nil),                  // 1: models.Agg
-	(*PriceUpdate)(nil),          // 2: models.PriceUpdate
-	(*Announcement)(nil),         // 3: models.Announcement
-	(*Screen)(nil),               // 4: models.Screen
-	(*ScreenCluster)(nil),        // 5: models.ScreenCluster
-	(*PresentationSettings)(nil), // 6: models.PresentationSettings
-	(*Update)(nil),               // 7: models.Update
-	(*RGBA)(nil),                 // 8: models.RGBA
-	(*Tickers)(nil),              // 9: models.Tickers
-	(*Empty)(nil),                // 10: models.Empty
+	(*Ticker)(nil),                            // 0: models.Ticker
+	(*Agg)(nil),                               // 1: models.Agg
+	(*PriceUpdate)(nil),                       // 2: models.PriceUpdate
+	(*Announcement)(nil),                      // 3: models.Announcement
+	(*Screen)(nil),                            // 4: models.Screen
+	(*SendStats)(nil),                         // 5: models.SendStats
+	(*ScreenCluster)(nil),                     // 6: models.ScreenCluster
+	(*PresentationSettings)(nil),              // 7: models.PresentationSettings
+	(*ScrollEpoch)(nil),                       // 8: models.ScrollEpoch
+	(*TickerLayout)(nil),                      // 9: models.TickerLayout
+	(*TickerBox)(nil),                         // 10: models.TickerBox
+	(*UpdatePresentationSettingsRequest)(nil), // 11: models.UpdatePresentationSettingsRequest
+	(*Update)(nil),                            // 12: models.Update
+	(*Snapshot)(nil),                          // 13: models.Snapshot
+	(*RGBA)(nil),                              // 14: models.RGBA
+	(*TickerRequest)(nil),                     // 15: models.TickerRequest
+	(*WallRequest)(nil),                       // 16: models.WallRequest
+	(*Walls)(nil),                             // 17: models.Walls
+	(*ClockSyncRequest)(nil),                  // 18: models.ClockSyncRequest
+	(*ClockSyncResponse)(nil),                 // 19: models.ClockSyncResponse
+	(*Tickers)(nil),                           // 20: models.Tickers
+	(*Announcements)(nil),                     // 21: models.Announcements
+	(*DataSourceHealth)(nil),                  // 22: models.DataSourceHealth
+	(*Empty)(nil),                             // 23: models.Empty
+	(*AccessControl)(nil),                     // 24: models.AccessControl
+	(*Role)(nil),                              // 25: models.Role
+	(*User)(nil),                              // 26: models.User
+	(*AuditLogRequest)(nil),                   // 27: models.AuditLogRequest
+	(*AuditLog)(nil),                          // 28: models.AuditLog
+	(*AuditEntry)(nil),                        // 29: models.AuditEntry
+	(*LeaderState)(nil),                       // 30: models.LeaderState
+	(*WallState)(nil),                         // 31: models.WallState
+	(*fieldmaskpb.FieldMask)(nil),             // 32: google.protobuf.FieldMask
+	(*anypb.Any)(nil),                         // 33: google.protobuf.Any
 }
 var file_models_proto_depIdxs = []int32{
 	1,  // 0: models.Ticker.Aggs:type_name -> models.Agg
-	6,  // 1: models.ScreenCluster.Settings:type_name -> models.PresentationSettings
-	4,  // 2: models.ScreenCluster.Screens:type_name -> models.Screen
-	8,  // 3: models.PresentationSettings.UpColor:type_name -> models.RGBA
-	8,  // 4: models.PresentationSettings.DownColor:type_name -> models.RGBA
-	8,  // 5: models.PresentationSettings.BGColor:type_name -> models.RGBA
-	8,  // 6: models.PresentationSettings.FontColor:type_name -> models.RGBA
-	8,  // 7: models.PresentationSettings.TickerBoxBGColor:type_name -> models.RGBA
-	2,  // 8: models.Update.PriceUpdate:type_name -> models.PriceUpdate
-	3,  // 9: models.Update.Announcement:type_name -> models.Announcement
-	5,  // 10: models.Update.ScreenCluster:type_name -> models.ScreenCluster
-	0,  // 11: models.Update.Ticker:type_name -> models.Ticker
-	6,  // 12: models.Update.PresentationSettings:type_name -> models.PresentationSettings
-	0,  // 13: models.Tickers.Tickers:type_name -> models.Ticker
-	4,  // 14: models.Leader.JoinCluster:input_type -> models.Screen
-	10, // 15: models.Leader.GetTickers:input_type -> models.Empty
-	6,  // 16: models.Leader.UpdatePresentationSettings:input_type -> models.PresentationSettings
-	3,  // 17: models.Leader.Announce:input_type -> models.Announcement
-	10, // 18: models.Leader.GetScreenCluster:input_type -> models.Empty
-	4,  // 19: models.Leader.UpdateScreen:input_type -> models.Screen
-	7,  // 20: models.Leader.JoinCluster:output_type -> models.Update
-	9,  // 21: models.Leader.GetTickers:output_type -> models.Tickers
-	6,  // 22: models.Leader.UpdatePresentationSettings:output_type -> models.PresentationSettings
-	3,  // 23: models.Leader.Announce:output_type -> models.Announcement
-	5,  // 24: models.Leader.GetScreenCluster:output_type -> models.ScreenCluster
-	4,  // 25: models.Leader.UpdateScreen:output_type -> models.Screen
-	20, // [20:26] is the sub-list for method output_type
-	14, // [14:20] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	5,  // 1: models.Screen.SendStats:type_name -> models.SendStats
+	7,  // 2: models.ScreenCluster.Settings:type_name -> models.PresentationSettings
+	4,  // 3: models.ScreenCluster.Screens:type_name -> models.Screen
+	14, // 4: models.PresentationSettings.UpColor:type_name -> models.RGBA
+	14, // 5: models.PresentationSettings.DownColor:type_name -> models.RGBA
+	14, // 6: models.PresentationSettings.BGColor:type_name -> models.RGBA
+	14, // 7: models.PresentationSettings.FontColor:type_name -> models.RGBA
+	14, // 8: models.PresentationSettings.TickerBoxBGColor:type_name -> models.RGBA
+	8,  // 9: models.PresentationSettings.ScrollEpoch:type_name -> models.ScrollEpoch
+	9,  // 10: models.PresentationSettings.TickerLayout:type_name -> models.TickerLayout
+	10, // 11: models.TickerLayout.Boxes:type_name -> models.TickerBox
+	7,  // 12: models.UpdatePresentationSettingsRequest.PresentationSettings:type_name -> models.PresentationSettings
+	32, // 13: models.UpdatePresentationSettingsRequest.UpdateMask:type_name -> google.protobuf.FieldMask
+	2,  // 14: models.Update.PriceUpdate:type_name -> models.PriceUpdate
+	3,  // 15: models.Update.Announcement:type_name -> models.Announcement
+	6,  // 16: models.Update.ScreenCluster:type_name -> models.ScreenCluster
+	0,  // 17: models.Update.Ticker:type_name -> models.Ticker
+	7,  // 18: models.Update.PresentationSettings:type_name -> models.PresentationSettings
+	13, // 19: models.Update.Snapshot:type_name -> models.Snapshot
+	6,  // 20: models.Snapshot.ScreenCluster:type_name -> models.ScreenCluster
+	0,  // 21: models.Snapshot.Tickers:type_name -> models.Ticker
+	3,  // 22: models.Snapshot.Announcements:type_name -> models.Announcement
+	0,  // 23: models.Tickers.Tickers:type_name -> models.Ticker
+	3,  // 24: models.Announcements.Announcements:type_name -> models.Announcement
+	25, // 25: models.AccessControl.Roles:type_name -> models.Role
+	26, // 26: models.AccessControl.Users:type_name -> models.User
+	29, // 27: models.AuditLog.Entries:type_name -> models.AuditEntry
+	33, // 28: models.AuditEntry.Before:type_name -> google.protobuf.Any
+	33, // 29: models.AuditEntry.After:type_name -> google.protobuf.Any
+	7,  // 30: models.LeaderState.PresentationSettings:type_name -> models.PresentationSettings
+	3,  // 31: models.LeaderState.Announcements:type_name -> models.Announcement
+	31, // 32: models.LeaderState.Walls:type_name -> models.WallState
+	7,  // 33: models.WallState.PresentationSettings:type_name -> models.PresentationSettings
+	3,  // 34: models.WallState.Announcements:type_name -> models.Announcement
+	4,  // 35: models.Leader.JoinCluster:input_type -> models.Screen
+	16, // 36: models.Leader.GetTickers:input_type -> models.WallRequest
+	11, // 37: models.Leader.UpdatePresentationSettings:input_type -> models.UpdatePresentationSettingsRequest
+	3,  // 38: models.Leader.Announce:input_type -> models.Announcement
+	16, // 39: models.Leader.GetScreenCluster:input_type -> models.WallRequest
+	4,  // 40: models.Leader.UpdateScreen:input_type -> models.Screen
+	15, // 41: models.Leader.AddTicker:input_type -> models.TickerRequest
+	15, // 42: models.Leader.RemoveTicker:input_type -> models.TickerRequest
+	16, // 43: models.Leader.ListTickers:input_type -> models.WallRequest
+	18, // 44: models.Leader.SyncClock:input_type -> models.ClockSyncRequest
+	23, // 45: models.Leader.ListWalls:input_type -> models.Empty
+	16, // 46: models.Leader.CreateWall:input_type -> models.WallRequest
+	16, // 47: models.Leader.DeleteWall:input_type -> models.WallRequest
+	16, // 48: models.Leader.ListAnnouncements:input_type -> models.WallRequest
+	23, // 49: models.Leader.GetDataSourceHealth:input_type -> models.Empty
+	4,  // 50: models.Leader.KickScreen:input_type -> models.Screen
+	23, // 51: models.Leader.GetAccessControl:input_type -> models.Empty
+	27, // 52: models.Leader.GetAuditLog:input_type -> models.AuditLogRequest
+	16, // 53: models.Leader.GetSnapshot:input_type -> models.WallRequest
+	12, // 54: models.Leader.JoinCluster:output_type -> models.Update
+	20, // 55: models.Leader.GetTickers:output_type -> models.Tickers
+	7,  // 56: models.Leader.UpdatePresentationSettings:output_type -> models.PresentationSettings
+	3,  // 57: models.Leader.Announce:output_type -> models.Announcement
+	6,  // 58: models.Leader.GetScreenCluster:output_type -> models.ScreenCluster
+	4,  // 59: models.Leader.UpdateScreen:output_type -> models.Screen
+	0,  // 60: models.Leader.AddTicker:output_type -> models.Ticker
+	0,  // 61: models.Leader.RemoveTicker:output_type -> models.Ticker
+	20, // 62: models.Leader.ListTickers:output_type -> models.Tickers
+	19, // 63: models.Leader.SyncClock:output_type -> models.ClockSyncResponse
+	17, // 64: models.Leader.ListWalls:output_type -> models.Walls
+	17, // 65: models.Leader.CreateWall:output_type -> models.Walls
+	17, // 66: models.Leader.DeleteWall:output_type -> models.Walls
+	21, // 67: models.Leader.ListAnnouncements:output_type -> models.Announcements
+	22, // 68: models.Leader.GetDataSourceHealth:output_type -> models.DataSourceHealth
+	4,  // 69: models.Leader.KickScreen:output_type -> models.Screen
+	24, // 70: models.Leader.GetAccessControl:output_type -> models.AccessControl
+	28, // 71: models.Leader.GetAuditLog:output_type -> models.AuditLog
+	13, // 72: models.Leader.GetSnapshot:output_type -> models.Snapshot
+	54, // [54:73] is the sub-list for method output_type
+	35, // [35:54] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_models_proto_init() }
@@ -1082,8 +3115,92 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Agg); i {
+		file_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Agg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Screen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenCluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresentationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScrollEpoch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1094,8 +3211,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceUpdate); i {
+		file_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickerLayout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1106,8 +3223,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Announcement); i {
+		file_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickerBox); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1118,8 +3235,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Screen); i {
+		file_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePresentationSettingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1130,8 +3247,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScreenCluster); i {
+		file_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1142,8 +3259,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PresentationSettings); i {
+		file_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1154,8 +3271,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Update); i {
+		file_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RGBA); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1166,8 +3283,8 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RGBA); i {
+		file_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TickerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1178,7 +3295,55 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Walls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClockSyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClockSyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Tickers); i {
 			case 0:
 				return &v.state
@@ -1190,7 +3355,31 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
-		file_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_models_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Announcements); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataSourceHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -1202,6 +3391,102 @@ func file_models_proto_init() {
 				return nil
 			}
 		}
+		file_models_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WallState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1209,7 +3494,7 @@ func file_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_models_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1238,15 +3523,42 @@ type LeaderClient interface {
 	// Join the screen cluster. Updates to the cluster will be streamed to clients.
 	JoinCluster(ctx context.Context, in *Screen, opts ...grpc.CallOption) (Leader_JoinClusterClient, error)
 	// Get our current list of tickers.
-	GetTickers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Tickers, error)
-	// Update our presentation settings.
-	UpdatePresentationSettings(ctx context.Context, in *PresentationSettings, opts ...grpc.CallOption) (*PresentationSettings, error)
+	GetTickers(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Tickers, error)
+	// Update our presentation settings. Only the fields in the update mask are changed.
+	UpdatePresentationSettings(ctx context.Context, in *UpdatePresentationSettingsRequest, opts ...grpc.CallOption) (*PresentationSettings, error)
 	// Announce a new message
 	Announce(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Announcement, error)
 	// Get our current screen cluster.
-	GetScreenCluster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScreenCluster, error)
+	GetScreenCluster(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*ScreenCluster, error)
 	// UpdateScreen allows a screen to update it's details after it's started and joined.
 	UpdateScreen(ctx context.Context, in *Screen, opts ...grpc.CallOption) (*Screen, error)
+	// Add a ticker to the ticker wall.
+	AddTicker(ctx context.Context, in *TickerRequest, opts ...grpc.CallOption) (*Ticker, error)
+	// Remove a ticker from the ticker wall.
+	RemoveTicker(ctx context.Context, in *TickerRequest, opts ...grpc.CallOption) (*Ticker, error)
+	// List the tickers on the ticker wall, without their aggregates.
+	ListTickers(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Tickers, error)
+	// SyncClock is used by screens to estimate the offset between their clock and the leaders clock.
+	SyncClock(ctx context.Context, in *ClockSyncRequest, opts ...grpc.CallOption) (*ClockSyncResponse, error)
+	// List the names of the walls served by the leader.
+	ListWalls(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Walls, error)
+	// Create a wall, which starts as a copy of the default wall's settings and tickers.
+	CreateWall(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Walls, error)
+	// Delete a wall, disconnecting it's screens. The default wall can't be deleted.
+	DeleteWall(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Walls, error)
+	// List the announcements of a wall which are showing, or scheduled to be shown.
+	ListAnnouncements(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Announcements, error)
+	// Get how the leaders market data source is doing.
+	GetDataSourceHealth(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataSourceHealth, error)
+	// Disconnect a screen from it's wall. The screen can join again, unless it's access is removed.
+	KickScreen(ctx context.Context, in *Screen, opts ...grpc.CallOption) (*Screen, error)
+	// Get the roles and users allowed to use the leader. Tokens are never included.
+	GetAccessControl(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccessControl, error)
+	// Get the audit log of the changes made to the walls, oldest first.
+	GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error)
+	// Get the full state of a wall, as of an update sequence. Screens use it to catch up when they
+	// miss updates.
+	GetSnapshot(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Snapshot, error)
 }
 
 type leaderClient struct {
@@ -1266,68 +3578,185 @@ func (c *leaderClient) JoinCluster(ctx context.Context, in *Screen, opts ...grpc
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Leader_JoinClusterClient interface {
+	Recv() (*Update, error)
+	grpc.ClientStream
+}
+
+type leaderJoinClusterClient struct {
+	grpc.ClientStream
+}
+
+func (x *leaderJoinClusterClient) Recv() (*Update, error) {
+	m := new(Update)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *leaderClient) GetTickers(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Tickers, error) {
+	out := new(Tickers)
+	err := c.cc.Invoke(ctx, "/models.Leader/GetTickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) UpdatePresentationSettings(ctx context.Context, in *UpdatePresentationSettingsRequest, opts ...grpc.CallOption) (*PresentationSettings, error) {
+	out := new(PresentationSettings)
+	err := c.cc.Invoke(ctx, "/models.Leader/UpdatePresentationSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) Announce(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Announcement, error) {
+	out := new(Announcement)
+	err := c.cc.Invoke(ctx, "/models.Leader/Announce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) GetScreenCluster(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*ScreenCluster, error) {
+	out := new(ScreenCluster)
+	err := c.cc.Invoke(ctx, "/models.Leader/GetScreenCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) UpdateScreen(ctx context.Context, in *Screen, opts ...grpc.CallOption) (*Screen, error) {
+	out := new(Screen)
+	err := c.cc.Invoke(ctx, "/models.Leader/UpdateScreen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) AddTicker(ctx context.Context, in *TickerRequest, opts ...grpc.CallOption) (*Ticker, error) {
+	out := new(Ticker)
+	err := c.cc.Invoke(ctx, "/models.Leader/AddTicker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) RemoveTicker(ctx context.Context, in *TickerRequest, opts ...grpc.CallOption) (*Ticker, error) {
+	out := new(Ticker)
+	err := c.cc.Invoke(ctx, "/models.Leader/RemoveTicker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) ListTickers(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Tickers, error) {
+	out := new(Tickers)
+	err := c.cc.Invoke(ctx, "/models.Leader/ListTickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) SyncClock(ctx context.Context, in *ClockSyncRequest, opts ...grpc.CallOption) (*ClockSyncResponse, error) {
+	out := new(ClockSyncResponse)
+	err := c.cc.Invoke(ctx, "/models.Leader/SyncClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaderClient) ListWalls(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Walls, error) {
+	out := new(Walls)
+	err := c.cc.Invoke(ctx, "/models.Leader/ListWalls", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type Leader_JoinClusterClient interface {
-	Recv() (*Update, error)
-	grpc.ClientStream
+func (c *leaderClient) CreateWall(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Walls, error) {
+	out := new(Walls)
+	err := c.cc.Invoke(ctx, "/models.Leader/CreateWall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type leaderJoinClusterClient struct {
-	grpc.ClientStream
+func (c *leaderClient) DeleteWall(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Walls, error) {
+	out := new(Walls)
+	err := c.cc.Invoke(ctx, "/models.Leader/DeleteWall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *leaderJoinClusterClient) Recv() (*Update, error) {
-	m := new(Update)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *leaderClient) ListAnnouncements(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Announcements, error) {
+	out := new(Announcements)
+	err := c.cc.Invoke(ctx, "/models.Leader/ListAnnouncements", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *leaderClient) GetTickers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Tickers, error) {
-	out := new(Tickers)
-	err := c.cc.Invoke(ctx, "/models.Leader/GetTickers", in, out, opts...)
+func (c *leaderClient) GetDataSourceHealth(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DataSourceHealth, error) {
+	out := new(DataSourceHealth)
+	err := c.cc.Invoke(ctx, "/models.Leader/GetDataSourceHealth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaderClient) UpdatePresentationSettings(ctx context.Context, in *PresentationSettings, opts ...grpc.CallOption) (*PresentationSettings, error) {
-	out := new(PresentationSettings)
-	err := c.cc.Invoke(ctx, "/models.Leader/UpdatePresentationSettings", in, out, opts...)
+func (c *leaderClient) KickScreen(ctx context.Context, in *Screen, opts ...grpc.CallOption) (*Screen, error) {
+	out := new(Screen)
+	err := c.cc.Invoke(ctx, "/models.Leader/KickScreen", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaderClient) Announce(ctx context.Context, in *Announcement, opts ...grpc.CallOption) (*Announcement, error) {
-	out := new(Announcement)
-	err := c.cc.Invoke(ctx, "/models.Leader/Announce", in, out, opts...)
+func (c *leaderClient) GetAccessControl(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AccessControl, error) {
+	out := new(AccessControl)
+	err := c.cc.Invoke(ctx, "/models.Leader/GetAccessControl", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaderClient) GetScreenCluster(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScreenCluster, error) {
-	out := new(ScreenCluster)
-	err := c.cc.Invoke(ctx, "/models.Leader/GetScreenCluster", in, out, opts...)
+func (c *leaderClient) GetAuditLog(ctx context.Context, in *AuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error) {
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, "/models.Leader/GetAuditLog", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *leaderClient) UpdateScreen(ctx context.Context, in *Screen, opts ...grpc.CallOption) (*Screen, error) {
-	out := new(Screen)
-	err := c.cc.Invoke(ctx, "/models.Leader/UpdateScreen", in, out, opts...)
+func (c *leaderClient) GetSnapshot(ctx context.Context, in *WallRequest, opts ...grpc.CallOption) (*Snapshot, error) {
+	out := new(Snapshot)
+	err := c.cc.Invoke(ctx, "/models.Leader/GetSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1339,15 +3768,42 @@ type LeaderServer interface {
 	// Join the screen cluster. Updates to the cluster will be streamed to clients.
 	JoinCluster(*Screen, Leader_JoinClusterServer) error
 	// Get our current list of tickers.
-	GetTickers(context.Context, *Empty) (*Tickers, error)
-	// Update our presentation settings.
-	UpdatePresentationSettings(context.Context, *PresentationSettings) (*PresentationSettings, error)
+	GetTickers(context.Context, *WallRequest) (*Tickers, error)
+	// Update our presentation settings. Only the fields in the update mask are changed.
+	UpdatePresentationSettings(context.Context, *UpdatePresentationSettingsRequest) (*PresentationSettings, error)
 	// Announce a new message
 	Announce(context.Context, *Announcement) (*Announcement, error)
 	// Get our current screen cluster.
-	GetScreenCluster(context.Context, *Empty) (*ScreenCluster, error)
+	GetScreenCluster(context.Context, *WallRequest) (*ScreenCluster, error)
 	// UpdateScreen allows a screen to update it's details after it's started and joined.
 	UpdateScreen(context.Context, *Screen) (*Screen, error)
+	// Add a ticker to the ticker wall.
+	AddTicker(context.Context, *TickerRequest) (*Ticker, error)
+	// Remove a ticker from the ticker wall.
+	RemoveTicker(context.Context, *TickerRequest) (*Ticker, error)
+	// List the tickers on the ticker wall, without their aggregates.
+	ListTickers(context.Context, *WallRequest) (*Tickers, error)
+	// SyncClock is used by screens to estimate the offset between their clock and the leaders clock.
+	SyncClock(context.Context, *ClockSyncRequest) (*ClockSyncResponse, error)
+	// List the names of the walls served by the leader.
+	ListWalls(context.Context, *Empty) (*Walls, error)
+	// Create a wall, which starts as a copy of the default wall's settings and tickers.
+	CreateWall(context.Context, *WallRequest) (*Walls, error)
+	// Delete a wall, disconnecting it's screens. The default wall can't be deleted.
+	DeleteWall(context.Context, *WallRequest) (*Walls, error)
+	// List the announcements of a wall which are showing, or scheduled to be shown.
+	ListAnnouncements(context.Context, *WallRequest) (*Announcements, error)
+	// Get how the leaders market data source is doing.
+	GetDataSourceHealth(context.Context, *Empty) (*DataSourceHealth, error)
+	// Disconnect a screen from it's wall. The screen can join again, unless it's access is removed.
+	KickScreen(context.Context, *Screen) (*Screen, error)
+	// Get the roles and users allowed to use the leader. Tokens are never included.
+	GetAccessControl(context.Context, *Empty) (*AccessControl, error)
+	// Get the audit log of the changes made to the walls, oldest first.
+	GetAuditLog(context.Context, *AuditLogRequest) (*AuditLog, error)
+	// Get the full state of a wall, as of an update sequence. Screens use it to catch up when they
+	// miss updates.
+	GetSnapshot(context.Context, *WallRequest) (*Snapshot, error)
 }
 
 // UnimplementedLeaderServer can be embedded to have forward compatible implementations.
@@ -1357,21 +3813,60 @@ type UnimplementedLeaderServer struct {
 func (*UnimplementedLeaderServer) JoinCluster(*Screen, Leader_JoinClusterServer) error {
 	return status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
 }
-func (*UnimplementedLeaderServer) GetTickers(context.Context, *Empty) (*Tickers, error) {
+func (*UnimplementedLeaderServer) GetTickers(context.Context, *WallRequest) (*Tickers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
 }
-func (*UnimplementedLeaderServer) UpdatePresentationSettings(context.Context, *PresentationSettings) (*PresentationSettings, error) {
+func (*UnimplementedLeaderServer) UpdatePresentationSettings(context.Context, *UpdatePresentationSettingsRequest) (*PresentationSettings, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePresentationSettings not implemented")
 }
 func (*UnimplementedLeaderServer) Announce(context.Context, *Announcement) (*Announcement, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Announce not implemented")
 }
-func (*UnimplementedLeaderServer) GetScreenCluster(context.Context, *Empty) (*ScreenCluster, error) {
+func (*UnimplementedLeaderServer) GetScreenCluster(context.Context, *WallRequest) (*ScreenCluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetScreenCluster not implemented")
 }
 func (*UnimplementedLeaderServer) UpdateScreen(context.Context, *Screen) (*Screen, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateScreen not implemented")
 }
+func (*UnimplementedLeaderServer) AddTicker(context.Context, *TickerRequest) (*Ticker, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTicker not implemented")
+}
+func (*UnimplementedLeaderServer) RemoveTicker(context.Context, *TickerRequest) (*Ticker, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTicker not implemented")
+}
+func (*UnimplementedLeaderServer) ListTickers(context.Context, *WallRequest) (*Tickers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickers not implemented")
+}
+func (*UnimplementedLeaderServer) SyncClock(context.Context, *ClockSyncRequest) (*ClockSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncClock not implemented")
+}
+func (*UnimplementedLeaderServer) ListWalls(context.Context, *Empty) (*Walls, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalls not implemented")
+}
+func (*UnimplementedLeaderServer) CreateWall(context.Context, *WallRequest) (*Walls, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWall not implemented")
+}
+func (*UnimplementedLeaderServer) DeleteWall(context.Context, *WallRequest) (*Walls, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWall not implemented")
+}
+func (*UnimplementedLeaderServer) ListAnnouncements(context.Context, *WallRequest) (*Announcements, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (*UnimplementedLeaderServer) GetDataSourceHealth(context.Context, *Empty) (*DataSourceHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataSourceHealth not implemented")
+}
+func (*UnimplementedLeaderServer) KickScreen(context.Context, *Screen) (*Screen, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickScreen not implemented")
+}
+func (*UnimplementedLeaderServer) GetAccessControl(context.Context, *Empty) (*AccessControl, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessControl not implemented")
+}
+func (*UnimplementedLeaderServer) GetAuditLog(context.Context, *AuditLogRequest) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (*UnimplementedLeaderServer) GetSnapshot(context.Context, *WallRequest) (*Snapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
+}
 
 func RegisterLeaderServer(s *grpc.Server, srv LeaderServer) {
 	s.RegisterService(&_Leader_serviceDesc, srv)
@@ -1399,7 +3894,7 @@ func (x *leaderJoinClusterServer) Send(m *Update) error {
 }
 
 func _Leader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(WallRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1411,13 +3906,13 @@ func _Leader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: "/models.Leader/GetTickers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaderServer).GetTickers(ctx, req.(*Empty))
+		return srv.(LeaderServer).GetTickers(ctx, req.(*WallRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Leader_UpdatePresentationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PresentationSettings)
+	in := new(UpdatePresentationSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1429,7 +3924,7 @@ func _Leader_UpdatePresentationSettings_Handler(srv interface{}, ctx context.Con
 		FullMethod: "/models.Leader/UpdatePresentationSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaderServer).UpdatePresentationSettings(ctx, req.(*PresentationSettings))
+		return srv.(LeaderServer).UpdatePresentationSettings(ctx, req.(*UpdatePresentationSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1453,7 +3948,7 @@ func _Leader_Announce_Handler(srv interface{}, ctx context.Context, dec func(int
 }
 
 func _Leader_GetScreenCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+	in := new(WallRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1465,7 +3960,7 @@ func _Leader_GetScreenCluster_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: "/models.Leader/GetScreenCluster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LeaderServer).GetScreenCluster(ctx, req.(*Empty))
+		return srv.(LeaderServer).GetScreenCluster(ctx, req.(*WallRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1488,6 +3983,240 @@ func _Leader_UpdateScreen_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Leader_AddTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).AddTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/AddTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).AddTicker(ctx, req.(*TickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_RemoveTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).RemoveTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/RemoveTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).RemoveTicker(ctx, req.(*TickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_ListTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).ListTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/ListTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).ListTickers(ctx, req.(*WallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_SyncClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClockSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).SyncClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/SyncClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).SyncClock(ctx, req.(*ClockSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_ListWalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).ListWalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/ListWalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).ListWalls(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_CreateWall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).CreateWall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/CreateWall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).CreateWall(ctx, req.(*WallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_DeleteWall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).DeleteWall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/DeleteWall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).DeleteWall(ctx, req.(*WallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/ListAnnouncements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).ListAnnouncements(ctx, req.(*WallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_GetDataSourceHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).GetDataSourceHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/GetDataSourceHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).GetDataSourceHealth(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_KickScreen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Screen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).KickScreen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/KickScreen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).KickScreen(ctx, req.(*Screen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_GetAccessControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).GetAccessControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/GetAccessControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).GetAccessControl(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/GetAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).GetAuditLog(ctx, req.(*AuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Leader_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaderServer).GetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.Leader/GetSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaderServer).GetSnapshot(ctx, req.(*WallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Leader_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "models.Leader",
 	HandlerType: (*LeaderServer)(nil),
@@ -1512,6 +4241,58 @@ var _Leader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateScreen",
 			Handler:    _Leader_UpdateScreen_Handler,
 		},
+		{
+			MethodName: "AddTicker",
+			Handler:    _Leader_AddTicker_Handler,
+		},
+		{
+			MethodName: "RemoveTicker",
+			Handler:    _Leader_RemoveTicker_Handler,
+		},
+		{
+			MethodName: "ListTickers",
+			Handler:    _Leader_ListTickers_Handler,
+		},
+		{
+			MethodName: "SyncClock",
+			Handler:    _Leader_SyncClock_Handler,
+		},
+		{
+			MethodName: "ListWalls",
+			Handler:    _Leader_ListWalls_Handler,
+		},
+		{
+			MethodName: "CreateWall",
+			Handler:    _Leader_CreateWall_Handler,
+		},
+		{
+			MethodName: "DeleteWall",
+			Handler:    _Leader_DeleteWall_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _Leader_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "GetDataSourceHealth",
+			Handler:    _Leader_GetDataSourceHealth_Handler,
+		},
+		{
+			MethodName: "KickScreen",
+			Handler:    _Leader_KickScreen_Handler,
+		},
+		{
+			MethodName: "GetAccessControl",
+			Handler:    _Leader_GetAccessControl_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _Leader_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "GetSnapshot",
+			Handler:    _Leader_GetSnapshot_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
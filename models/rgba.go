@@ -1,6 +1,10 @@
 package models
 
-import "github.com/polygon-io/nanovgo"
+import (
+	"image/color"
+
+	"github.com/polygon-io/nanovgo"
+)
 
 func (g *RGBA) ToNanov() nanovgo.Color {
 	return nanovgo.RGBA(
@@ -10,3 +14,13 @@ func (g *RGBA) ToNanov() nanovgo.Color {
 		uint8(g.Alpha),
 	)
 }
+
+// ToNRGBA converts the color for use with a renderer.
+func (g *RGBA) ToNRGBA() color.NRGBA {
+	return color.NRGBA{
+		R: uint8(g.Red),
+		G: uint8(g.Green),
+		B: uint8(g.Blue),
+		A: uint8(g.Alpha),
+	}
+}
@@ -1,39 +1,132 @@
 package models
 
+import "sort"
+
 // This has helper functions for ScreenCluster model.
 
-// ScreenSlice is sortable by index.
+// ScreenSlice is sortable by row, then index.
 type ScreenSlice []*Screen
 
-func (a ScreenSlice) Len() int           { return len(a) }
-func (a ScreenSlice) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
-func (a ScreenSlice) Less(i, j int) bool { return a[i].Index < a[j].Index }
+func (a ScreenSlice) Len() int      { return len(a) }
+func (a ScreenSlice) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a ScreenSlice) Less(i, j int) bool {
+	if a[i].Row != a[j].Row {
+		return a[i].Row < a[j].Row
+	}
+	return a[i].Index < a[j].Index
+}
+
+// Rows groups the screens by their row, top to bottom. Each row is ordered left to right.
+func (s *ScreenCluster) Rows() [][]*Screen {
+	screens := make(ScreenSlice, len(s.Screens))
+	copy(screens, s.Screens)
+	sort.Stable(screens)
+
+	var rows [][]*Screen
+	for i, scr := range screens {
+		if i == 0 || scr.Row != screens[i-1].Row {
+			rows = append(rows, nil)
+		}
+		rows[len(rows)-1] = append(rows[len(rows)-1], scr)
+	}
+	return rows
+}
 
-// ScreenGlobalOffset gets the global offset for a given screen UUID in the cluster.
+// ScreenGlobalOffset gets the global offset for a given screen UUID in the cluster. This is the
+// offset along the tape, which continues from the end of one row to the start of the next. It
+// accounts for the bezels and gaps between screens, so content lines up across the physical screens.
 func (s *ScreenCluster) ScreenGlobalOffset(screenUUID string) float32 {
 	var offset float32
-	for _, scr := range s.Screens {
-		// This is our screen, do not add our own width.
-		if scr.UUID == screenUUID {
-			break
+	for _, row := range s.Rows() {
+		if rowOffset, ok := screenRowOffset(row, screenUUID); ok {
+			return offset + rowOffset
 		}
 
-		// Otherwise add this screens offset to the global offset.
-		offset += float32(scr.Width)
+		// Otherwise add this rows width to the global offset.
+		offset += rowWidth(row)
 	}
 	return offset
 }
 
+// ScreenPosition gets the position of the top left corner of a given screen UUID on the wall.
+func (s *ScreenCluster) ScreenPosition(screenUUID string) (x, y float32) {
+	for _, row := range s.Rows() {
+		if rowOffset, ok := screenRowOffset(row, screenUUID); ok {
+			return rowOffset, y
+		}
+
+		y += float32(rowHeight(row))
+	}
+	return 0, y
+}
+
 // NumberOfScreens returns the total number of screen devices in the cluster.
 func (s *ScreenCluster) NumberOfScreens() int {
 	return len(s.Screens)
 }
 
-// GlobalViewportSize gets the entire pixel width of the cluster.
+// GlobalViewportSize gets the entire pixel width of the cluster. This is the length of tape which
+// is visible across all rows, from the left edge of the first screen in each row to the right edge
+// of the last screen.
 func (s *ScreenCluster) GlobalViewportSize() int {
 	globalViewportSize := 0
-	for _, scr := range s.Screens {
-		globalViewportSize += int(scr.Width)
+	for _, row := range s.Rows() {
+		globalViewportSize += int(rowWidth(row))
 	}
 	return globalViewportSize
 }
+
+// CanvasSize gets the pixel size of the entire wall, as it is physically laid out. The width is
+// the widest row, and the height is the sum of each rows height.
+func (s *ScreenCluster) CanvasSize() (width, height int) {
+	for _, row := range s.Rows() {
+		if w := int(rowWidth(row)); w > width {
+			width = w
+		}
+		height += int(rowHeight(row))
+	}
+	return width, height
+}
+
+// screenRowOffset gets the offset of a screen within it's row, and if it was found in the row.
+func screenRowOffset(row []*Screen, screenUUID string) (float32, bool) {
+	var offset float32
+	for i, scr := range row {
+		// Skip over our left bezel, unless we are the first screen ( which the row starts at ).
+		if i > 0 {
+			offset += float32(scr.BezelLeft)
+		}
+
+		// This is our screen, do not add our own width.
+		if scr.UUID == screenUUID {
+			return offset, true
+		}
+
+		// Otherwise add this screens offset to the row offset.
+		offset += float32(scr.Width + scr.BezelRight + scr.Gap)
+	}
+	return offset, false
+}
+
+// rowWidth gets the pixel width of a row, from the left edge of the first screen to the right
+// edge of the last screen.
+func rowWidth(row []*Screen) float32 {
+	if len(row) == 0 {
+		return 0
+	}
+
+	last := row[len(row)-1]
+	offset, _ := screenRowOffset(row, last.UUID)
+	return offset + float32(last.Width)
+}
+
+// rowHeight gets the pixel height of a row, which is it's tallest screen.
+func rowHeight(row []*Screen) int32 {
+	var height int32
+	for _, scr := range row {
+		if scr.Height > height {
+			height = scr.Height
+		}
+	}
+	return height
+}
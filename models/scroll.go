@@ -0,0 +1,78 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// This has helper functions for scrolling the ticker tape.
+
+// ScrollVelocity gets the scroll velocity ( pixels per millisecond ) at the given time.
+func (s *PresentationSettings) ScrollVelocity(now time.Time) float64 {
+	velocity := s.targetVelocity()
+
+	epoch := s.ScrollEpoch
+	if epoch == nil || epoch.EaseDurationMS <= 0 {
+		return velocity
+	}
+
+	elapsed := float64(now.UnixMilli() - epoch.TimestampMS)
+	duration := float64(epoch.EaseDurationMS)
+	if elapsed >= duration {
+		return velocity
+	} else if elapsed <= 0 {
+		return epoch.StartVelocity
+	}
+
+	// Linear ease from the start velocity into the target velocity.
+	return epoch.StartVelocity + ((velocity - epoch.StartVelocity) * (elapsed / duration))
+}
+
+// ScrollOffset gets the tape offset, in pixels, at the given time. The offset is not wrapped to the
+// width of the tape, see WrapOffset.
+func (s *PresentationSettings) ScrollOffset(now time.Time) float64 {
+	velocity := s.targetVelocity()
+
+	// Without an epoch, the tape has been scrolling since the unix epoch.
+	epoch := s.ScrollEpoch
+	if epoch == nil {
+		return float64(now.UnixNano()) / float64(time.Millisecond) * velocity
+	}
+
+	elapsed := float64(now.UnixNano())/float64(time.Millisecond) - float64(epoch.TimestampMS)
+	if epoch.EaseDurationMS <= 0 || elapsed <= 0 {
+		return epoch.Offset + (velocity * elapsed)
+	}
+
+	// The distance travelled while easing is the area under the ( linear ) velocity ramp.
+	duration := float64(epoch.EaseDurationMS)
+	if elapsed < duration {
+		acceleration := (velocity - epoch.StartVelocity) / duration
+		return epoch.Offset + (epoch.StartVelocity * elapsed) + (acceleration * elapsed * elapsed / 2)
+	}
+
+	easedDistance := (epoch.StartVelocity + velocity) / 2 * duration
+	return epoch.Offset + easedDistance + (velocity * (elapsed - duration))
+}
+
+// targetVelocity is the velocity of the current scroll speed, in pixels per millisecond. The
+// scroll speed is inverted, it's the number of milliseconds it takes to move 1 pixel.
+func (s *PresentationSettings) targetVelocity() float64 {
+	if s.ScrollSpeed <= 0 {
+		return 0
+	}
+	return 1 / float64(s.ScrollSpeed)
+}
+
+// WrapOffset wraps a tape offset to be within [0, tapeWidth).
+func WrapOffset(offset, tapeWidth float64) float64 {
+	if tapeWidth <= 0 {
+		return 0
+	}
+
+	offset = math.Mod(offset, tapeWidth)
+	if offset < 0 {
+		offset += tapeWidth
+	}
+	return offset
+}
@@ -0,0 +1,42 @@
+package models
+
+// This has helper functions for positioning tickers on the tape.
+
+// Box finds the box of a ticker in the layout, nil if it isn't in the layout.
+func (l *TickerLayout) Box(symbol string) *TickerBox {
+	for _, box := range l.GetBoxes() {
+		if box.Ticker == symbol {
+			return box
+		}
+	}
+	return nil
+}
+
+// TapeWidth gets the width of one repetition of the tape, including the spacer, in pixels. The
+// tape repeats itself for as long as is needed to fill the wall.
+func (s *PresentationSettings) TapeWidth(tickerCount int) float64 {
+	return s.TickersWidth(tickerCount) + float64(s.SpacerWidth)
+}
+
+// TickersWidth gets the width of all of the ticker boxes, in pixels. The spacer starts at the end
+// of the tickers.
+func (s *PresentationSettings) TickersWidth(tickerCount int) float64 {
+	if s.TickerLayout != nil {
+		return float64(s.TickerLayout.TapeWidth)
+	}
+	return float64(tickerCount) * float64(s.TickerBoxWidth)
+}
+
+// TickerBox gets the position of a ticker on the tape, and if it has a position. Without a layout
+// every ticker is the same width, and positioned by it's index.
+func (s *PresentationSettings) TickerBox(ticker *Ticker) (offset, width float64, ok bool) {
+	if s.TickerLayout == nil {
+		return float64(ticker.Index) * float64(s.TickerBoxWidth), float64(s.TickerBoxWidth), true
+	}
+
+	box := s.TickerLayout.Box(ticker.Ticker)
+	if box == nil {
+		return 0, 0, false
+	}
+	return float64(box.Offset), float64(box.Width), true
+}
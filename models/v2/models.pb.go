@@ -0,0 +1,568 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: v2/models.proto
+
+package modelsv2
+
+import (
+	context "context"
+	models "github.com/polygon-io/go-app-ticker-wall/models"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Handshake is the first update of a stream. It's the protocol version the rest of the stream is
+// sent with, and the versions the leader supports.
+type Handshake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolVersion    int32 `protobuf:"varint,1,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"`
+	MinProtocolVersion int32 `protobuf:"varint,2,opt,name=MinProtocolVersion,proto3" json:"MinProtocolVersion,omitempty"`
+	MaxProtocolVersion int32 `protobuf:"varint,3,opt,name=MaxProtocolVersion,proto3" json:"MaxProtocolVersion,omitempty"`
+}
+
+func (x *Handshake) Reset() {
+	*x = Handshake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_models_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Handshake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handshake) ProtoMessage() {}
+
+func (x *Handshake) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_models_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handshake.ProtoReflect.Descriptor instead.
+func (*Handshake) Descriptor() ([]byte, []int) {
+	return file_v2_models_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Handshake) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Handshake) GetMinProtocolVersion() int32 {
+	if x != nil {
+		return x.MinProtocolVersion
+	}
+	return 0
+}
+
+func (x *Handshake) GetMaxProtocolVersion() int32 {
+	if x != nil {
+		return x.MaxProtocolVersion
+	}
+	return 0
+}
+
+// Update is a change to a screens wall. Exactly one payload is set.
+type Update struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Wall the update is for, empty updates are for every wall.
+	Wall string `protobuf:"bytes,1,opt,name=Wall,proto3" json:"Wall,omitempty"`
+	// Sequence numbers the updates of a wall, each is one more than the last. Price updates aren't
+	// numbered, they are 0.
+	Sequence int64 `protobuf:"varint,2,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Update_Handshake
+	//	*Update_Snapshot
+	//	*Update_ScreenCluster
+	//	*Update_TickerAdded
+	//	*Update_TickerRemoved
+	//	*Update_TickerUpdated
+	//	*Update_Price
+	//	*Update_Announcement
+	//	*Update_PresentationSettings
+	Payload isUpdate_Payload `protobuf_oneof:"Payload"`
+}
+
+func (x *Update) Reset() {
+	*x = Update{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_models_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Update) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Update) ProtoMessage() {}
+
+func (x *Update) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_models_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Update.ProtoReflect.Descriptor instead.
+func (*Update) Descriptor() ([]byte, []int) {
+	return file_v2_models_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Update) GetWall() string {
+	if x != nil {
+		return x.Wall
+	}
+	return ""
+}
+
+func (x *Update) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (m *Update) GetPayload() isUpdate_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Update) GetHandshake() *Handshake {
+	if x, ok := x.GetPayload().(*Update_Handshake); ok {
+		return x.Handshake
+	}
+	return nil
+}
+
+func (x *Update) GetSnapshot() *models.Snapshot {
+	if x, ok := x.GetPayload().(*Update_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *Update) GetScreenCluster() *models.ScreenCluster {
+	if x, ok := x.GetPayload().(*Update_ScreenCluster); ok {
+		return x.ScreenCluster
+	}
+	return nil
+}
+
+func (x *Update) GetTickerAdded() *models.Ticker {
+	if x, ok := x.GetPayload().(*Update_TickerAdded); ok {
+		return x.TickerAdded
+	}
+	return nil
+}
+
+func (x *Update) GetTickerRemoved() *models.Ticker {
+	if x, ok := x.GetPayload().(*Update_TickerRemoved); ok {
+		return x.TickerRemoved
+	}
+	return nil
+}
+
+func (x *Update) GetTickerUpdated() *models.Ticker {
+	if x, ok := x.GetPayload().(*Update_TickerUpdated); ok {
+		return x.TickerUpdated
+	}
+	return nil
+}
+
+func (x *Update) GetPrice() *models.PriceUpdate {
+	if x, ok := x.GetPayload().(*Update_Price); ok {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Update) GetAnnouncement() *models.Announcement {
+	if x, ok := x.GetPayload().(*Update_Announcement); ok {
+		return x.Announcement
+	}
+	return nil
+}
+
+func (x *Update) GetPresentationSettings() *models.PresentationSettings {
+	if x, ok := x.GetPayload().(*Update_PresentationSettings); ok {
+		return x.PresentationSettings
+	}
+	return nil
+}
+
+type isUpdate_Payload interface {
+	isUpdate_Payload()
+}
+
+type Update_Handshake struct {
+	Handshake *Handshake `protobuf:"bytes,3,opt,name=Handshake,proto3,oneof"`
+}
+
+type Update_Snapshot struct {
+	Snapshot *models.Snapshot `protobuf:"bytes,4,opt,name=Snapshot,proto3,oneof"`
+}
+
+type Update_ScreenCluster struct {
+	ScreenCluster *models.ScreenCluster `protobuf:"bytes,5,opt,name=ScreenCluster,proto3,oneof"`
+}
+
+type Update_TickerAdded struct {
+	TickerAdded *models.Ticker `protobuf:"bytes,6,opt,name=TickerAdded,proto3,oneof"`
+}
+
+type Update_TickerRemoved struct {
+	TickerRemoved *models.Ticker `protobuf:"bytes,7,opt,name=TickerRemoved,proto3,oneof"`
+}
+
+type Update_TickerUpdated struct {
+	TickerUpdated *models.Ticker `protobuf:"bytes,8,opt,name=TickerUpdated,proto3,oneof"`
+}
+
+type Update_Price struct {
+	Price *models.PriceUpdate `protobuf:"bytes,9,opt,name=Price,proto3,oneof"`
+}
+
+type Update_Announcement struct {
+	Announcement *models.Announcement `protobuf:"bytes,10,opt,name=Announcement,proto3,oneof"`
+}
+
+type Update_PresentationSettings struct {
+	PresentationSettings *models.PresentationSettings `protobuf:"bytes,11,opt,name=PresentationSettings,proto3,oneof"`
+}
+
+func (*Update_Handshake) isUpdate_Payload() {}
+
+func (*Update_Snapshot) isUpdate_Payload() {}
+
+func (*Update_ScreenCluster) isUpdate_Payload() {}
+
+func (*Update_TickerAdded) isUpdate_Payload() {}
+
+func (*Update_TickerRemoved) isUpdate_Payload() {}
+
+func (*Update_TickerUpdated) isUpdate_Payload() {}
+
+func (*Update_Price) isUpdate_Payload() {}
+
+func (*Update_Announcement) isUpdate_Payload() {}
+
+func (*Update_PresentationSettings) isUpdate_Payload() {}
+
+var File_v2_models_proto protoreflect.FileDescriptor
+
+var file_v2_models_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x76, 0x32, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x32, 0x1a, 0x0c, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x48,
+	0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x4d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xc9, 0x04, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x57, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57, 0x61, 0x6c,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a,
+	0x09, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x48, 0x61, 0x6e,
+	0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x48, 0x00, 0x52, 0x09, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68,
+	0x61, 0x6b, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0d, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x32, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x36,
+	0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x0c, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x52, 0x0a, 0x14, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x14, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x32, 0x3e,
+	0x0a, 0x06, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x1a, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x3d,
+	0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x6c,
+	0x79, 0x67, 0x6f, 0x6e, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6f, 0x2d, 0x61, 0x70, 0x70, 0x2d, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x2d, 0x77, 0x61, 0x6c, 0x6c, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2f, 0x76, 0x32, 0x3b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x76, 0x32, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v2_models_proto_rawDescOnce sync.Once
+	file_v2_models_proto_rawDescData = file_v2_models_proto_rawDesc
+)
+
+func file_v2_models_proto_rawDescGZIP() []byte {
+	file_v2_models_proto_rawDescOnce.Do(func() {
+		file_v2_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_v2_models_proto_rawDescData)
+	})
+	return file_v2_models_proto_rawDescData
+}
+
+var file_v2_models_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v2_models_proto_goTypes = []interface{}{
+	(*Handshake)(nil),                   // 0: models.v2.Handshake
+	(*Update)(nil),                      // 1: models.v2.Update
+	(*models.Snapshot)(nil),             // 2: models.Snapshot
+	(*models.ScreenCluster)(nil),        // 3: models.ScreenCluster
+	(*models.Ticker)(nil),               // 4: models.Ticker
+	(*models.PriceUpdate)(nil),          // 5: models.PriceUpdate
+	(*models.Announcement)(nil),         // 6: models.Announcement
+	(*models.PresentationSettings)(nil), // 7: models.PresentationSettings
+	(*models.Screen)(nil),               // 8: models.Screen
+}
+var file_v2_models_proto_depIdxs = []int32{
+	0,  // 0: models.v2.Update.Handshake:type_name -> models.v2.Handshake
+	2,  // 1: models.v2.Update.Snapshot:type_name -> models.Snapshot
+	3,  // 2: models.v2.Update.ScreenCluster:type_name -> models.ScreenCluster
+	4,  // 3: models.v2.Update.TickerAdded:type_name -> models.Ticker
+	4,  // 4: models.v2.Update.TickerRemoved:type_name -> models.Ticker
+	4,  // 5: models.v2.Update.TickerUpdated:type_name -> models.Ticker
+	5,  // 6: models.v2.Update.Price:type_name -> models.PriceUpdate
+	6,  // 7: models.v2.Update.Announcement:type_name -> models.Announcement
+	7,  // 8: models.v2.Update.PresentationSettings:type_name -> models.PresentationSettings
+	8,  // 9: models.v2.Leader.JoinCluster:input_type -> models.Screen
+	1,  // 10: models.v2.Leader.JoinCluster:output_type -> models.v2.Update
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_v2_models_proto_init() }
+func file_v2_models_proto_init() {
+	if File_v2_models_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v2_models_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Handshake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Update); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v2_models_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Update_Handshake)(nil),
+		(*Update_Snapshot)(nil),
+		(*Update_ScreenCluster)(nil),
+		(*Update_TickerAdded)(nil),
+		(*Update_TickerRemoved)(nil),
+		(*Update_TickerUpdated)(nil),
+		(*Update_Price)(nil),
+		(*Update_Announcement)(nil),
+		(*Update_PresentationSettings)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v2_models_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v2_models_proto_goTypes,
+		DependencyIndexes: file_v2_models_proto_depIdxs,
+		MessageInfos:      file_v2_models_proto_msgTypes,
+	}.Build()
+	File_v2_models_proto = out.File
+	file_v2_models_proto_rawDesc = nil
+	file_v2_models_proto_goTypes = nil
+	file_v2_models_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// LeaderClient is the client API for Leader service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LeaderClient interface {
+	// Join the screen cluster. The stream starts with a handshake, followed by a snapshot of the
+	// wall and it's updates. The screen gives the newest protocol version it supports.
+	JoinCluster(ctx context.Context, in *models.Screen, opts ...grpc.CallOption) (Leader_JoinClusterClient, error)
+}
+
+type leaderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLeaderClient(cc grpc.ClientConnInterface) LeaderClient {
+	return &leaderClient{cc}
+}
+
+func (c *leaderClient) JoinCluster(ctx context.Context, in *models.Screen, opts ...grpc.CallOption) (Leader_JoinClusterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Leader_serviceDesc.Streams[0], "/models.v2.Leader/JoinCluster", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &leaderJoinClusterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Leader_JoinClusterClient interface {
+	Recv() (*Update, error)
+	grpc.ClientStream
+}
+
+type leaderJoinClusterClient struct {
+	grpc.ClientStream
+}
+
+func (x *leaderJoinClusterClient) Recv() (*Update, error) {
+	m := new(Update)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// LeaderServer is the server API for Leader service.
+type LeaderServer interface {
+	// Join the screen cluster. The stream starts with a handshake, followed by a snapshot of the
+	// wall and it's updates. The screen gives the newest protocol version it supports.
+	JoinCluster(*models.Screen, Leader_JoinClusterServer) error
+}
+
+// UnimplementedLeaderServer can be embedded to have forward compatible implementations.
+type UnimplementedLeaderServer struct {
+}
+
+func (*UnimplementedLeaderServer) JoinCluster(*models.Screen, Leader_JoinClusterServer) error {
+	return status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
+}
+
+func RegisterLeaderServer(s *grpc.Server, srv LeaderServer) {
+	s.RegisterService(&_Leader_serviceDesc, srv)
+}
+
+func _Leader_JoinCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(models.Screen)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LeaderServer).JoinCluster(m, &leaderJoinClusterServer{stream})
+}
+
+type Leader_JoinClusterServer interface {
+	Send(*Update) error
+	grpc.ServerStream
+}
+
+type leaderJoinClusterServer struct {
+	grpc.ServerStream
+}
+
+func (x *leaderJoinClusterServer) Send(m *Update) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Leader_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "models.v2.Leader",
+	HandlerType: (*LeaderServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "JoinCluster",
+			Handler:       _Leader_JoinCluster_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v2/models.proto",
+}
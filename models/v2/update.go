@@ -0,0 +1,78 @@
+package modelsv2
+
+import (
+	"fmt"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// FromV1 converts a v1 update to a v2 update.
+func FromV1(update *models.Update) (*Update, error) {
+	res := &Update{
+		Wall:     update.Wall,
+		Sequence: update.Sequence,
+	}
+
+	switch models.UpdateType(update.UpdateType) {
+	case models.UpdateTypeSnapshot:
+		res.Payload = &Update_Snapshot{Snapshot: update.Snapshot}
+	case models.UpdateTypeCluster:
+		res.Payload = &Update_ScreenCluster{ScreenCluster: update.ScreenCluster}
+	case models.UpdateTypeTickerAdded:
+		res.Payload = &Update_TickerAdded{TickerAdded: update.Ticker}
+	case models.UpdateTypeTickerRemoved:
+		res.Payload = &Update_TickerRemoved{TickerRemoved: update.Ticker}
+	case models.UpdateTypeTickerUpdate:
+		res.Payload = &Update_TickerUpdated{TickerUpdated: update.Ticker}
+	case models.UpdateTypePrice:
+		res.Payload = &Update_Price{Price: update.PriceUpdate}
+	case models.UpdateTypeAnnouncement:
+		res.Payload = &Update_Announcement{Announcement: update.Announcement}
+	case models.UpdatePresentationSettings:
+		res.Payload = &Update_PresentationSettings{PresentationSettings: update.PresentationSettings}
+	default:
+		return nil, fmt.Errorf("update type %d has no v2 payload", update.UpdateType)
+	}
+
+	return res, nil
+}
+
+// V1 converts the update to a v1 update. Handshakes, and payloads added by newer leaders, are
+// UpdateTypeUnknown.
+func (x *Update) V1() *models.Update {
+	res := &models.Update{
+		Wall:     x.Wall,
+		Sequence: x.Sequence,
+	}
+
+	switch payload := x.Payload.(type) {
+	case *Update_Snapshot:
+		res.UpdateType = int32(models.UpdateTypeSnapshot)
+		res.Snapshot = payload.Snapshot
+	case *Update_ScreenCluster:
+		res.UpdateType = int32(models.UpdateTypeCluster)
+		res.ScreenCluster = payload.ScreenCluster
+	case *Update_TickerAdded:
+		res.UpdateType = int32(models.UpdateTypeTickerAdded)
+		res.Ticker = payload.TickerAdded
+	case *Update_TickerRemoved:
+		res.UpdateType = int32(models.UpdateTypeTickerRemoved)
+		res.Ticker = payload.TickerRemoved
+	case *Update_TickerUpdated:
+		res.UpdateType = int32(models.UpdateTypeTickerUpdate)
+		res.Ticker = payload.TickerUpdated
+	case *Update_Price:
+		res.UpdateType = int32(models.UpdateTypePrice)
+		res.PriceUpdate = payload.Price
+	case *Update_Announcement:
+		res.UpdateType = int32(models.UpdateTypeAnnouncement)
+		res.Announcement = payload.Announcement
+	case *Update_PresentationSettings:
+		res.UpdateType = int32(models.UpdatePresentationSettings)
+		res.PresentationSettings = payload.PresentationSettings
+	default:
+		res.UpdateType = int32(models.UpdateTypeUnknown)
+	}
+
+	return res
+}
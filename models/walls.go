@@ -0,0 +1,15 @@
+package models
+
+import "strings"
+
+// DefaultWall is the wall screens and commands use when they don't select one.
+const DefaultWall = "default"
+
+// WallName normalizes a wall name, empty names are the default wall.
+func WallName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return DefaultWall
+	}
+	return name
+}
@@ -27,7 +27,7 @@ type company struct {
 }
 
 type Client struct {
-	PriceUpdates   chan *models.PriceUpdate
+	priceUpdates   chan *models.PriceUpdate
 	perTickUpdates bool
 	wsClient       *websocket.Conn
 
@@ -48,13 +48,18 @@ func NewClient(apiKey string, perTickUpdate bool) (*Client, error) {
 	}
 
 	return &Client{
-		PriceUpdates:    make(chan *models.PriceUpdate, bufferedChannelSize),
+		priceUpdates:    make(chan *models.PriceUpdate, bufferedChannelSize),
 		perTickUpdates:  perTickUpdate,
 		restClient:      polygon.New(apiKey),
 		websocketClient: wsclient,
 	}, nil
 }
 
+// PriceUpdates is the channel real-time price updates are published on.
+func (c *Client) PriceUpdates() <-chan *models.PriceUpdate {
+	return c.priceUpdates
+}
+
 func (c *Client) LoadTickerData(ctx context.Context, tickerSymbol string) (*models.Ticker, error) {
 	ctx, cancel := context.WithTimeout(ctx, time.Second*5)
 	defer cancel()
@@ -17,13 +17,8 @@ func (c *Client) ListenForTickerUpdates(ctx context.Context, tickers []string) e
 
 	defer c.websocketClient.Close()
 
-	topic := polygonws.StocksSecAggs
-	if c.perTickUpdates {
-		topic = polygonws.StocksTrades
-	}
-
-	if err := c.websocketClient.Subscribe(topic, tickers...); err != nil {
-		return fmt.Errorf("subscribe websocket: %w", err)
+	if err := c.Subscribe(tickers...); err != nil {
+		return err
 	}
 
 	for {
@@ -38,13 +33,13 @@ func (c *Client) ListenForTickerUpdates(ctx context.Context, tickers []string) e
 			switch msg.(type) {
 			case polygonws_models.EquityAgg:
 				agg := msg.(polygonws_models.EquityAgg)
-				c.PriceUpdates <- &models.PriceUpdate{
+				c.priceUpdates <- &models.PriceUpdate{
 					Ticker: agg.Symbol,
 					Price:  agg.Close,
 				}
 			case polygonws_models.EquityTrade:
 				trade := msg.(polygonws_models.EquityTrade)
-				c.PriceUpdates <- &models.PriceUpdate{
+				c.priceUpdates <- &models.PriceUpdate{
 					Ticker: trade.Symbol,
 					Price:  trade.Price,
 				}
@@ -52,3 +47,39 @@ func (c *Client) ListenForTickerUpdates(ctx context.Context, tickers []string) e
 		}
 	}
 }
+
+// Subscribe starts streaming price updates for the given tickers.
+func (c *Client) Subscribe(tickers ...string) error {
+	// No tickers means all tickers to the websocket client, which is not what we want.
+	if len(tickers) == 0 {
+		return nil
+	}
+
+	if err := c.websocketClient.Subscribe(c.topic(), tickers...); err != nil {
+		return fmt.Errorf("subscribe websocket: %w", err)
+	}
+
+	return nil
+}
+
+// Unsubscribe stops streaming price updates for the given tickers.
+func (c *Client) Unsubscribe(tickers ...string) error {
+	// No tickers means all tickers to the websocket client, which is not what we want.
+	if len(tickers) == 0 {
+		return nil
+	}
+
+	if err := c.websocketClient.Unsubscribe(c.topic(), tickers...); err != nil {
+		return fmt.Errorf("unsubscribe websocket: %w", err)
+	}
+
+	return nil
+}
+
+// topic is the websocket topic we get price updates from.
+func (c *Client) topic() polygonws.Topic {
+	if c.perTickUpdates {
+		return polygonws.StocksTrades
+	}
+	return polygonws.StocksSecAggs
+}
@@ -0,0 +1,104 @@
+// Package recording reads and writes recordings of the leaders update stream. A recording is a
+// JSON lines file, where each line is a single update along with the time it was received.
+package recording
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ErrBadEntry is returned by Next when a line of the recording can't be parsed. The line is
+// skipped, so reading can carry on from the next line.
+var ErrBadEntry = errors.New("bad recording entry")
+
+// entry is a single line of a recording.
+type entry struct {
+	TimestampMS int64           `json:"timestamp_ms"`
+	Update      json.RawMessage `json:"update"`
+}
+
+// Writer writes updates to a recording.
+type Writer struct {
+	w *bufio.Writer
+}
+
+// NewWriter creates a new recording writer. Flush must be called once all updates are written.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w: bufio.NewWriter(w),
+	}
+}
+
+// Write adds an update, received at the given time, to the recording.
+func (w *Writer) Write(receivedAt time.Time, update *models.Update) error {
+	updateJSON, err := protojson.Marshal(update)
+	if err != nil {
+		return fmt.Errorf("unable to marshal update: %w", err)
+	}
+
+	line, err := json.Marshal(&entry{
+		TimestampMS: receivedAt.UnixMilli(),
+		Update:      updateJSON,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to marshal recording entry: %w", err)
+	}
+
+	if _, err := w.w.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Flush writes any buffered updates to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Reader reads updates from a recording.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader creates a new recording reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r: bufio.NewReader(r),
+	}
+}
+
+// Next returns the next update in the recording, along with the time it was received. io.EOF is
+// returned when the end of the recording is reached, and ErrBadEntry when a line can't be parsed.
+func (r *Reader) Next() (time.Time, *models.Update, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return time.Time{}, nil, err
+		}
+
+		// Skip blank lines.
+		if len(line) == 1 && line[0] == '\n' {
+			continue
+		}
+
+		var e entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return time.Time{}, nil, fmt.Errorf("%w: unable to parse recording entry: %v", ErrBadEntry, err)
+		}
+
+		update := &models.Update{}
+		if err := protojson.Unmarshal(e.Update, update); err != nil {
+			return time.Time{}, nil, fmt.Errorf("%w: unable to parse recorded update: %v", ErrBadEntry, err)
+		}
+
+		return time.UnixMilli(e.TimestampMS), update, nil
+	}
+}
@@ -0,0 +1,92 @@
+package recording
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoundTrip(t *testing.T) {
+	start := time.UnixMilli(1650000000000)
+	updates := []*models.Update{
+		{UpdateType: int32(models.UpdateTypeTickerAdded), Ticker: &models.Ticker{Ticker: "AAPL", Price: 150}},
+		{UpdateType: int32(models.UpdateTypePrice), PriceUpdate: &models.PriceUpdate{Ticker: "AAPL", Price: 151}},
+		{UpdateType: int32(models.UpdateTypeAnnouncement), Announcement: &models.Announcement{Message: "Hello"}},
+	}
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for i, update := range updates {
+		if err := w.Write(start.Add(time.Duration(i)*1500*time.Millisecond), update); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewReader(&buf)
+	for i, want := range updates {
+		receivedAt, update, err := r.Next()
+		if err != nil {
+			t.Fatalf("update %d: %v", i, err)
+		}
+		if wantAt := start.Add(time.Duration(i) * 1500 * time.Millisecond); !receivedAt.Equal(wantAt) {
+			t.Errorf("update %d received at %v, want %v", i, receivedAt, wantAt)
+		}
+		if !proto.Equal(update, want) {
+			t.Errorf("update %d = %v, want %v", i, update, want)
+		}
+	}
+
+	if _, _, err := r.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("reading past the end returned %v, want io.EOF", err)
+	}
+}
+
+func TestReadEmpty(t *testing.T) {
+	for _, recording := range []string{"", "\n\n"} {
+		if _, _, err := NewReader(strings.NewReader(recording)).Next(); !errors.Is(err, io.EOF) {
+			t.Errorf("reading %q returned %v, want io.EOF", recording, err)
+		}
+	}
+}
+
+func TestReadBadLines(t *testing.T) {
+	recording := strings.Join([]string{
+		`{"timestamp_ms":1000,"update":{"UpdateType":6,"PriceUpdate":{"Ticker":"AAPL","Price":1}}}`,
+		`not json`,
+		`{"timestamp_ms":2000,"update":{"UpdateType":"nope"}}`,
+		`{"timestamp_ms":3000,"update":{"UpdateType":6,"PriceUpdate":{"Ticker":"AAPL","Price":3}}}`,
+		// Cut off while it was being written.
+		`{"timestamp_ms":4000,"update":{"Upda`,
+	}, "\n")
+
+	// Bad lines are reported, and reading carries on from the next line.
+	r := NewReader(strings.NewReader(recording))
+	for _, want := range []int64{1000, 0, 0, 3000, 0} {
+		receivedAt, _, err := r.Next()
+		if want == 0 {
+			if !errors.Is(err, ErrBadEntry) {
+				t.Errorf("reading a bad line returned %v, want ErrBadEntry", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if receivedAt.UnixMilli() != want {
+			t.Errorf("update received at %d, want %d", receivedAt.UnixMilli(), want)
+		}
+	}
+
+	if _, _, err := r.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("reading past the end returned %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,100 @@
+package render
+
+import (
+	"errors"
+	"image/color"
+
+	"github.com/polygon-io/nanovgo"
+)
+
+// NanoVG is a renderer which draws using a nanovgo context. It must only be used from the render
+// thread, between the contexts BeginFrame and EndFrame.
+type NanoVG struct {
+	ctx *nanovgo.Context
+}
+
+// NewNanoVG creates a renderer which draws to the nanovgo context. The fonts need to already be
+// loaded into the context.
+func NewNanoVG(ctx *nanovgo.Context) *NanoVG {
+	return &NanoVG{
+		ctx: ctx,
+	}
+}
+
+// TextWidth measures how wide the text is when drawn using the given font face and size.
+func (n *NanoVG) TextWidth(face string, size float32, text string) float32 {
+	n.ctx.SetFontFace(face)
+	n.ctx.SetFontSize(size)
+	width, _ := n.ctx.TextBounds(0, 0, text)
+	return width
+}
+
+func (n *NanoVG) Save() {
+	n.ctx.Save()
+}
+
+func (n *NanoVG) Restore() {
+	n.ctx.Restore()
+}
+
+func (n *NanoVG) Translate(x, y float32) {
+	n.ctx.Translate(x, y)
+}
+
+func (n *NanoVG) FillRect(x, y, w, h, radius float32, c color.NRGBA) {
+	n.ctx.BeginPath()
+	n.ctx.RoundedRect(x, y, w, h, radius)
+	n.ctx.SetFillColor(toNanov(c))
+	n.ctx.Fill()
+}
+
+func (n *NanoVG) FillCircle(x, y, radius float32, c color.NRGBA) {
+	n.ctx.BeginPath()
+	n.ctx.Circle(x, y, radius)
+	n.ctx.SetFillColor(toNanov(c))
+	n.ctx.Fill()
+}
+
+func (n *NanoVG) StrokePath(points []Point, width float32, c color.NRGBA) {
+	if len(points) < 2 {
+		return
+	}
+
+	n.ctx.BeginPath()
+	n.ctx.MoveTo(points[0].X, points[0].Y)
+	for _, point := range points[1:] {
+		n.ctx.LineTo(point.X, point.Y)
+	}
+	n.ctx.SetStrokeColor(toNanov(c))
+	n.ctx.SetStrokeWidth(width)
+	n.ctx.Stroke()
+}
+
+func (n *NanoVG) Text(x, y float32, style TextStyle, text string) {
+	n.ctx.SetFontFace(style.Face)
+	n.ctx.SetFontSize(style.Size)
+	n.ctx.SetTextAlign(nanovgo.Align(style.Align))
+	n.ctx.SetFillColor(toNanov(style.Color))
+	n.ctx.Text(x, y, text)
+}
+
+// CreateImage loads the image into the context. This must be called from the render thread.
+func (n *NanoVG) CreateImage(data []byte) (Image, error) {
+	img := n.ctx.CreateImageFromMemory(0, data)
+	if img == 0 {
+		return 0, errors.New("unable to load image")
+	}
+	return Image(img), nil
+}
+
+func (n *NanoVG) DrawImage(img Image, x, y, w, h, radius float32) {
+	n.ctx.BeginPath()
+	n.ctx.RoundedRect(x, y, w, h, radius)
+	n.ctx.SetFillPaint(nanovgo.ImagePattern(x, y, w, h, 0, int(img), 1))
+	n.ctx.Fill()
+}
+
+// toNanov converts a color to a nanovgo color.
+func toNanov(c color.NRGBA) nanovgo.Color {
+	return nanovgo.RGBA(c.R, c.G, c.B, c.A)
+}
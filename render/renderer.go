@@ -0,0 +1,66 @@
+// Package render has the drawing primitives the ticker wall is drawn with. The nanovgo renderer
+// draws to an OpenGL window, and the software renderer draws to an in memory image so frames can
+// be rendered without a GPU.
+package render
+
+import "image/color"
+
+// Align is how text is aligned to the point it's drawn at. Combine one horizontal and one vertical
+// alignment. These match nanovgo's alignments.
+type Align int
+
+const (
+	// Horizontal alignment.
+	AlignLeft Align = 1 << iota
+	AlignCenter
+	AlignRight
+
+	// Vertical alignment.
+	AlignTop
+	AlignMiddle
+	AlignBottom
+	AlignBaseline
+)
+
+// Point is a position on the screen.
+type Point struct {
+	X, Y float32
+}
+
+// TextStyle is how text is drawn.
+type TextStyle struct {
+	Face  string
+	Size  float32
+	Align Align
+	Color color.NRGBA
+}
+
+// Image is a handle to an image loaded into a renderer.
+type Image int
+
+// Renderer draws shapes, text and images.
+type Renderer interface {
+	// TextWidth measures how wide the text is, in pixels, when drawn using the given font face and size.
+	TextWidth(face string, size float32, text string) float32
+
+	// Save pushes the current translation onto a stack, Restore pops it back off.
+	Save()
+	Restore()
+	// Translate moves where everything is drawn by x, y.
+	Translate(x, y float32)
+
+	// FillRect fills a rectangle, with rounded corners when radius is more than 0.
+	FillRect(x, y, w, h, radius float32, c color.NRGBA)
+	// FillCircle fills a circle centered at x, y.
+	FillCircle(x, y, radius float32, c color.NRGBA)
+	// StrokePath draws a line through each of the points.
+	StrokePath(points []Point, width float32, c color.NRGBA)
+
+	// Text draws a single line of text.
+	Text(x, y float32, style TextStyle, text string)
+
+	// CreateImage loads an encoded image ( png, jpeg or gif ) so it can be drawn.
+	CreateImage(data []byte) (Image, error)
+	// DrawImage draws an image scaled to fill the rectangle, with rounded corners when radius is more than 0.
+	DrawImage(img Image, x, y, w, h, radius float32)
+}
@@ -0,0 +1,266 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	// Image formats CreateImage can load.
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	"github.com/polygon-io/go-app-ticker-wall/fonts"
+	"github.com/polygon-io/nanovgo/fontstashmini"
+)
+
+// softwareAtlasSize is the size of the glyph atlas. The wall only uses a few font sizes, so this
+// never needs to grow.
+const softwareAtlasSize = 2048
+
+// Software is a renderer which draws to an in memory image using the CPU. Shapes are anti-aliased
+// and text is rasterized from the same TrueType fonts the GUI uses, so frames look the same as they
+// do on screen without needing an OpenGL context.
+type Software struct {
+	img *image.RGBA
+
+	// Glyphs are rasterized into the font stash's atlas, then drawn from there.
+	stash *fontstashmini.FontStash
+
+	// Current translation, and the saved translations.
+	offset Point
+	saved  []Point
+
+	images []image.Image
+}
+
+// NewSoftware creates a software renderer which draws to a new, transparent, image of the given size.
+func NewSoftware(width, height int) *Software {
+	stash := fontstashmini.New(softwareAtlasSize, softwareAtlasSize)
+	fonts.AddFonts(stash)
+
+	return &Software{
+		img:   image.NewRGBA(image.Rect(0, 0, width, height)),
+		stash: stash,
+	}
+}
+
+// Image is what has been drawn so far.
+func (s *Software) Image() *image.RGBA {
+	return s.img
+}
+
+// TextWidth measures how wide the text is when drawn using the given font face and size.
+func (s *Software) TextWidth(face string, size float32, text string) float32 {
+	if !s.setFont(face, size, AlignLeft|AlignBaseline) {
+		return 0
+	}
+
+	width, _ := s.stash.TextBounds(0, 0, text)
+	return width
+}
+
+func (s *Software) Save() {
+	s.saved = append(s.saved, s.offset)
+}
+
+func (s *Software) Restore() {
+	if len(s.saved) == 0 {
+		return
+	}
+	s.offset = s.saved[len(s.saved)-1]
+	s.saved = s.saved[:len(s.saved)-1]
+}
+
+func (s *Software) Translate(x, y float32) {
+	s.offset.X += x
+	s.offset.Y += y
+}
+
+func (s *Software) FillRect(x, y, w, h, radius float32, c color.NRGBA) {
+	x0, y0, x1, y1 := s.rect(x, y, w, h)
+
+	// Square corners on whole pixels don't need any anti-aliasing.
+	if radius <= 0 && isWhole(x0) && isWhole(y0) && isWhole(x1) && isWhole(y1) {
+		r := image.Rect(int(x0), int(y0), int(x1), int(y1))
+		draw.Draw(s.img, r, image.NewUniform(c), image.Point{}, draw.Over)
+		return
+	}
+
+	s.fill(x0, y0, x1, y1, func(px, py float64) float64 {
+		return roundedRectDistance(px, py, x0, y0, x1, y1, float64(radius))
+	}, func(int, int) color.NRGBA { return c })
+}
+
+func (s *Software) FillCircle(x, y, radius float32, c color.NRGBA) {
+	cx, cy, r := float64(x+s.offset.X), float64(y+s.offset.Y), float64(radius)
+
+	s.fill(cx-r, cy-r, cx+r, cy+r, func(px, py float64) float64 {
+		return math.Hypot(px-cx, py-cy) - r
+	}, func(int, int) color.NRGBA { return c })
+}
+
+func (s *Software) StrokePath(points []Point, width float32, c color.NRGBA) {
+	if len(points) < 2 {
+		return
+	}
+
+	// Find the bounds of the line.
+	halfWidth := float64(width) / 2
+	x0, y0 := math.Inf(1), math.Inf(1)
+	x1, y1 := math.Inf(-1), math.Inf(-1)
+	translated := make([]Point, len(points))
+	for i, point := range points {
+		translated[i] = Point{X: point.X + s.offset.X, Y: point.Y + s.offset.Y}
+		x0, y0 = math.Min(x0, float64(translated[i].X)), math.Min(y0, float64(translated[i].Y))
+		x1, y1 = math.Max(x1, float64(translated[i].X)), math.Max(y1, float64(translated[i].Y))
+	}
+
+	s.fill(x0-halfWidth, y0-halfWidth, x1+halfWidth, y1+halfWidth, func(px, py float64) float64 {
+		distance := math.Inf(1)
+		for i := 1; i < len(translated); i++ {
+			distance = math.Min(distance, segmentDistance(px, py, translated[i-1], translated[i]))
+		}
+		return distance - halfWidth
+	}, func(int, int) color.NRGBA { return c })
+}
+
+func (s *Software) Text(x, y float32, style TextStyle, text string) {
+	if !s.setFont(style.Face, style.Size, style.Align) {
+		return
+	}
+
+	data, atlasWidth, atlasHeight := s.stash.GetTextureData()
+	atlas := &image.Alpha{
+		Pix:    data,
+		Stride: atlasWidth,
+		Rect:   image.Rect(0, 0, atlasWidth, atlasHeight),
+	}
+	src := image.NewUniform(style.Color)
+
+	iter := s.stash.TextIter(x+s.offset.X, y+s.offset.Y, text)
+	for {
+		quad, ok := iter.Next()
+		if !ok {
+			break
+		}
+
+		// Glyph quads are whole pixels, and the same size as the glyph in the atlas.
+		r := image.Rect(int(quad.X0), int(quad.Y0), int(quad.X1), int(quad.Y1))
+		if r.Empty() {
+			continue
+		}
+		maskPoint := image.Pt(int(quad.S0*float32(atlasWidth)+0.5), int(quad.T0*float32(atlasHeight)+0.5))
+		draw.DrawMask(s.img, r, src, image.Point{}, atlas, maskPoint, draw.Over)
+	}
+}
+
+func (s *Software) CreateImage(data []byte) (Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("unable to load image: %w", err)
+	}
+
+	// Image handles start at 1, like nanovgo's.
+	s.images = append(s.images, img)
+	return Image(len(s.images)), nil
+}
+
+func (s *Software) DrawImage(img Image, x, y, w, h, radius float32) {
+	if img <= 0 || int(img) > len(s.images) {
+		return
+	}
+	src := s.images[img-1]
+	bounds := src.Bounds()
+
+	x0, y0, x1, y1 := s.rect(x, y, w, h)
+	s.fill(x0, y0, x1, y1, func(px, py float64) float64 {
+		return roundedRectDistance(px, py, x0, y0, x1, y1, float64(radius))
+	}, func(px, py int) color.NRGBA {
+		// Nearest neighbor scaling.
+		sx := bounds.Min.X + int((float64(px)+0.5-x0)/(x1-x0)*float64(bounds.Dx()))
+		sy := bounds.Min.Y + int((float64(py)+0.5-y0)/(y1-y0)*float64(bounds.Dy()))
+		return color.NRGBAModel.Convert(src.At(sx, sy)).(color.NRGBA)
+	})
+}
+
+// setFont sets the font stash up to draw or measure text. Returns false if the font face doesn't exist.
+func (s *Software) setFont(face string, size float32, align Align) bool {
+	font := s.stash.GetFontByName(face)
+	if font == fontstashmini.INVALID {
+		return false
+	}
+
+	s.stash.SetFont(font)
+	s.stash.SetSize(size)
+	s.stash.SetAlign(fontstashmini.FONSAlign(align))
+	return true
+}
+
+// rect translates a rectangle, and normalizes it so x0, y0 is the top left corner.
+func (s *Software) rect(x, y, w, h float32) (x0, y0, x1, y1 float64) {
+	x0, y0 = float64(x+s.offset.X), float64(y+s.offset.Y)
+	x1, y1 = x0+float64(w), y0+float64(h)
+	return math.Min(x0, x1), math.Min(y0, y1), math.Max(x0, x1), math.Max(y0, y1)
+}
+
+// fill blends a shape into the image. distance is the signed distance from the center of a pixel
+// to the edge of the shape ( negative inside of it ), which is used to anti-alias the edges.
+func (s *Software) fill(x0, y0, x1, y1 float64, distance func(px, py float64) float64, colorAt func(px, py int) color.NRGBA) {
+	bounds := image.Rect(int(math.Floor(x0)), int(math.Floor(y0)), int(math.Ceil(x1)), int(math.Ceil(y1))).Intersect(s.img.Bounds())
+
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			coverage := 0.5 - distance(float64(px)+0.5, float64(py)+0.5)
+			if coverage <= 0 {
+				continue
+			}
+			s.blend(px, py, colorAt(px, py), math.Min(coverage, 1))
+		}
+	}
+}
+
+// blend draws a color over a pixel, with the given coverage.
+func (s *Software) blend(px, py int, c color.NRGBA, coverage float64) {
+	alpha := float64(c.A) / 255 * coverage
+	if alpha <= 0 {
+		return
+	}
+
+	// The image is alpha premultiplied.
+	pix := s.img.Pix[s.img.PixOffset(px, py):]
+	pix[0] = uint8(float64(c.R)*alpha + float64(pix[0])*(1-alpha) + 0.5)
+	pix[1] = uint8(float64(c.G)*alpha + float64(pix[1])*(1-alpha) + 0.5)
+	pix[2] = uint8(float64(c.B)*alpha + float64(pix[2])*(1-alpha) + 0.5)
+	pix[3] = uint8(255*alpha + float64(pix[3])*(1-alpha) + 0.5)
+}
+
+// roundedRectDistance is the signed distance from a point to the edge of a rounded rectangle.
+func roundedRectDistance(px, py, x0, y0, x1, y1, radius float64) float64 {
+	halfWidth, halfHeight := (x1-x0)/2, (y1-y0)/2
+	radius = math.Max(0, math.Min(radius, math.Min(halfWidth, halfHeight)))
+
+	qx := math.Abs(px-(x0+halfWidth)) - (halfWidth - radius)
+	qy := math.Abs(py-(y0+halfHeight)) - (halfHeight - radius)
+
+	return math.Hypot(math.Max(qx, 0), math.Max(qy, 0)) + math.Min(math.Max(qx, qy), 0) - radius
+}
+
+// segmentDistance is the distance from a point to a line segment.
+func segmentDistance(px, py float64, a, b Point) float64 {
+	ax, ay := float64(a.X), float64(a.Y)
+	dx, dy := float64(b.X)-ax, float64(b.Y)-ay
+
+	t := 0.0
+	if lengthSquared := dx*dx + dy*dy; lengthSquared > 0 {
+		t = math.Max(0, math.Min(1, ((px-ax)*dx+(py-ay)*dy)/lengthSquared))
+	}
+	return math.Hypot(px-(ax+t*dx), py-(ay+t*dy))
+}
+
+func isWhole(f float64) bool {
+	return f == math.Trunc(f)
+}
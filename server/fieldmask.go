@@ -0,0 +1,46 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// jsonFieldPaths returns the field mask paths of the fields present in a JSON object. This lets
+// HTTP requests only update the fields they include, including setting values to false or 0.
+// Nested messages are walked, so {"UpColor": {"Red": 255}} only updates the red channel, and empty
+// objects have no paths.
+func jsonFieldPaths(body []byte, md protoreflect.MessageDescriptor) ([]string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for key, value := range fields {
+		fd := md.Fields().ByJSONName(key)
+		if fd == nil {
+			fd = md.Fields().ByName(protoreflect.Name(key))
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field: %s", key)
+		}
+
+		// Walk into nested objects, anything else updates the whole field.
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() && len(value) > 0 && value[0] == '{' {
+			nested, err := jsonFieldPaths(value, fd.Message())
+			if err != nil {
+				return nil, err
+			}
+			for _, path := range nested {
+				paths = append(paths, string(fd.Name())+"."+path)
+			}
+			continue
+		}
+
+		paths = append(paths, string(fd.Name()))
+	}
+
+	return paths, nil
+}
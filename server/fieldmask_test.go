@@ -0,0 +1,80 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/polygon-io/go-app-ticker-wall/leader"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+func TestJSONFieldPaths(t *testing.T) {
+	tests := []struct {
+		body    string
+		want    []string
+		wantErr bool
+	}{
+		{body: `{}`},
+		{body: `{"UpColor": {}}`},
+		{body: `{"ScrollSpeed": 0}`, want: []string{"ScrollSpeed"}},
+		{body: `{"UpColor": {"Red": 255}, "ScrollSpeed": 5}`, want: []string{"ScrollSpeed", "UpColor.Red"}},
+		{body: `{"UpColor": {}, "ScrollSpeed": 5}`, want: []string{"ScrollSpeed"}},
+		{body: `{"UpColor": null}`, want: []string{"UpColor"}},
+		{body: `{"Recolor": true}`, wantErr: true},
+		{body: `[]`, wantErr: true},
+	}
+
+	md := (&models.PresentationSettings{}).ProtoReflect().Descriptor()
+	for _, tt := range tests {
+		paths, err := jsonFieldPaths([]byte(tt.body), md)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("jsonFieldPaths(%s) error = %v, want error %v", tt.body, err, tt.wantErr)
+			continue
+		}
+
+		sort.Strings(paths)
+		if strings.Join(paths, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("jsonFieldPaths(%s) = %v, want %v", tt.body, paths, tt.want)
+		}
+	}
+}
+
+func TestUpdateSettingsWithoutFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	leaderObj, err := leader.New(&leader.Config{
+		TickerList: "AAPL",
+		Source:     leader.SourceSim,
+		Presentation: &models.PresentationSettings{
+			ScrollSpeed: 5,
+			UpColor:     &models.RGBA{Green: 255, Alpha: 255},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := gin.New()
+	r.PATCH("/v1/settings", updateSettings(leaderObj))
+
+	// None of these change anything, so they shouldn't replace all of the settings.
+	for _, body := range []string{``, `{}`, `{"UpColor": {}}`} {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("PATCH", "/v1/settings", strings.NewReader(body)))
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("PATCH /v1/settings %q = %d, want %d", body, w.Code, http.StatusBadRequest)
+		}
+	}
+
+	cluster, err := leaderObj.CurrentScreenCluster(models.DefaultWall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings := cluster.Settings; settings.ScrollSpeed != 5 || settings.UpColor.GetGreen() != 255 {
+		t.Errorf("settings = %v, want them unchanged", settings)
+	}
+}
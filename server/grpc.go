@@ -5,19 +5,27 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/leader"
 	"github.com/polygon-io/go-app-ticker-wall/models"
+	modelsv2 "github.com/polygon-io/go-app-ticker-wall/models/v2"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 )
 
 // startGRPC starts the gRPC server. When the given context ends, it will shutdown the gRPC server.
-func startGRPC(ctx context.Context, port int, tickerWallLeader models.LeaderServer) error {
+func startGRPC(ctx context.Context, port int, authCfg *auth.Config, tickerWallLeader *leader.Leader) error {
+	// TLS and token checks.
+	opts, err := authCfg.ServerOptions()
+	if err != nil {
+		return fmt.Errorf("unable to secure gRPC server: %w", err)
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", port))
 	if err != nil {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
-	var opts []grpc.ServerOption
 	grpcServer := grpc.NewServer(opts...)
 	go func() {
 		<-ctx.Done()
@@ -25,6 +33,8 @@ func startGRPC(ctx context.Context, port int, tickerWallLeader models.LeaderServ
 		grpcServer.Stop()
 	}()
 
+	// Screens from before and after the v2 protocol can join during a rollout.
 	models.RegisterLeaderServer(grpcServer, tickerWallLeader)
+	modelsv2.RegisterLeaderServer(grpcServer, tickerWallLeader.V2())
 	return grpcServer.Serve(lis)
 }
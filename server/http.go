@@ -1,20 +1,34 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
-	"time"
+	"net/url"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/imdario/mergo"
+	"github.com/polygon-io/go-app-ticker-wall/auth"
 	"github.com/polygon-io/go-app-ticker-wall/leader"
 	"github.com/polygon-io/go-app-ticker-wall/models"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
-func runHTTPServer(ctx context.Context, port int, leaderObj *leader.Leader) error {
-	r := gin.Default()
+func runHTTPServer(ctx context.Context, port int, authCfg *auth.Config, leaderObj *leader.Leader) error {
+	tlsConfig, err := authCfg.ServerTLS()
+	if err != nil {
+		return fmt.Errorf("unable to secure HTTP server: %w", err)
+	}
+
+	r := gin.New()
+	r.Use(gin.LoggerWithFormatter(logRequest), gin.Recovery())
 	r.GET("/ping", func(c *gin.Context) {
 		c.JSON(200, gin.H{
 			"message": "pong",
@@ -22,13 +36,15 @@ func runHTTPServer(ctx context.Context, port int, leaderObj *leader.Leader) erro
 	})
 
 	// Register routes.
-	r.GET("/v1/cluster", getCluster(leaderObj))
-	r.POST("/v1/presentation", updatePresentation(leaderObj))
-	r.POST("/v1/announcement", createAnnouncement(leaderObj))
+	registerAPIRoutes(r, authCfg, leaderObj)
+
+	// Browser client.
+	registerWebRoutes(r)
 
 	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
-		Handler: r,
+		Addr:      fmt.Sprintf(":%d", port),
+		Handler:   r,
+		TLSConfig: tlsConfig,
 	}
 
 	// Gracefully shutdown the HTTP server when context is closed.
@@ -40,72 +56,447 @@ func runHTTPServer(ctx context.Context, port int, leaderObj *leader.Leader) erro
 	}()
 
 	logrus.Info("HTTP Server Listening on: ", port)
+	if tlsConfig != nil {
+		// The certificate is already in the TLS config.
+		return srv.ListenAndServeTLS("", "")
+	}
 	return srv.ListenAndServe()
 }
 
-func createAnnouncement(leaderObj *leader.Leader) func(*gin.Context) {
+// logRequest is gin's default request log line, with access tokens redacted.
+func logRequest(param gin.LogFormatterParams) string {
+	path := param.Path
+	if u, err := url.Parse(path); err == nil && u.Query().Has("access_token") {
+		query := u.Query()
+		query.Set("access_token", "REDACTED")
+		u.RawQuery = query.Encode()
+		path = u.String()
+	}
+
+	return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %#v\n%s",
+		param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+		param.StatusCode,
+		param.Latency,
+		param.ClientIP,
+		param.Method,
+		path,
+		param.ErrorMessage,
+	)
+}
+
+// authorize rejects requests which can't use the RPC a route mirrors. Browsers can't set headers
+// on WebSockets, so the token can also be given as the access_token query parameter. The identity
+// of the request is added to it's context, for the leader.
+func authorize(authCfg *auth.Config, rpc string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		var announcement *models.Announcement
-		if err := c.ShouldBindJSON(&announcement); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if !authCfg.AccessControlEnabled() {
+			c.Next()
 			return
 		}
 
-		// Set the display time of the announcement to +100 ms from now.
-		startTimer := time.Now().Add(100 * time.Millisecond)
-		announcement.ShowAtTimestampMS = startTimer.UnixNano() / int64(time.Millisecond)
+		authorization := c.GetHeader("Authorization")
+		if token := c.Query("access_token"); token != "" {
+			authorization = auth.Bearer(token)
+		}
 
-		// Tell all screen clients to update.
-		leaderObj.Updates <- &models.Update{
-			UpdateType:   int32(models.UpdateTypeAnnouncement),
-			Announcement: announcement,
+		id, err := authCfg.Authenticate(authorization, c.Request.TLS)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+		if err := id.Authorize(rpc); err != nil {
+			writeError(c, err)
+			return
 		}
 
-		c.JSON(200, gin.H{
-			"done":    true,
-			"results": announcement,
+		c.Request = c.Request.WithContext(auth.NewContext(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+// readProto parses a protojson request body into msg. The body is returned, so the fields which
+// were sent can be found. An empty body is allowed.
+func readProto(c *gin.Context, msg proto.Message) ([]byte, error) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to read request body: %v", err)
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return []byte("{}"), nil
+	}
+
+	if err := protojson.Unmarshal(body, msg); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	return body, nil
+}
+
+// writeProto responds with a protojson message.
+func writeProto(c *gin.Context, msg proto.Message) {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		writeError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json", data)
+}
+
+// writeError responds with an error. Every error has the same body, with the gRPC status code so
+// errors read the same over HTTP and gRPC, eg: {"error": "wall lobby does not exist", "code": "NotFound"}
+func writeError(c *gin.Context, err error) {
+	st := status.Convert(err)
+	c.AbortWithStatusJSON(httpStatus(st.Code()), gin.H{
+		"error": st.Message(),
+		"code":  st.Code().String(),
+	})
+}
+
+// httpStatus is the HTTP status for a gRPC status code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func listWalls(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		walls, err := leaderObj.ListWalls(c.Request.Context(), &models.Empty{})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, walls)
+	}
+}
+
+func createWall(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &models.WallRequest{}
+		if _, err := readProto(c, req); err != nil {
+			writeError(c, err)
+			return
+		}
+
+		// The wall can be given in the body or the query.
+		if wall := c.Query("wall"); wall != "" {
+			req.Wall = wall
+		}
+
+		walls, err := leaderObj.CreateWall(c.Request.Context(), req)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, walls)
+	}
+}
+
+func deleteWall(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		walls, err := leaderObj.DeleteWall(c.Request.Context(), &models.WallRequest{Wall: c.Param("wall")})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, walls)
+	}
+}
+
+func getCluster(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cluster, err := leaderObj.GetScreenCluster(c.Request.Context(), &models.WallRequest{Wall: c.Query("wall")})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, cluster)
+	}
+}
+
+func updateScreen(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		changes := &models.Screen{}
+		body, err := readProto(c, changes)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		// Only the fields which were sent are changed, the rest keep their current value.
+		paths, err := jsonFieldPaths(body, changes.ProtoReflect().Descriptor())
+		if err != nil {
+			writeError(c, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		// An empty mask replaces the whole screen, that's only for gRPC callers which ask for it.
+		if len(paths) == 0 {
+			writeError(c, status.Error(codes.InvalidArgument, "no fields to update"))
+			return
+		}
+
+		changes.UUID = c.Param("uuid")
+		changes.Wall = c.Query("wall")
+		updated, err := leaderObj.PatchScreen(c.Request.Context(), changes, &fieldmaskpb.FieldMask{Paths: paths})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, updated)
+	}
+}
+
+func kickScreen(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		screen, err := leaderObj.KickScreen(c.Request.Context(), &models.Screen{
+			UUID: c.Param("uuid"),
+			Wall: c.Query("wall"),
 		})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, screen)
+	}
+}
+
+func getSettings(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cluster, err := leaderObj.GetScreenCluster(c.Request.Context(), &models.WallRequest{Wall: c.Query("wall")})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, cluster.Settings)
 	}
 }
 
-func updatePresentation(leaderObj *leader.Leader) func(*gin.Context) {
+func updateSettings(leaderObj *leader.Leader) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Parse incoming settings.
-		var presentationSettings *models.PresentationSettings
-		if err := c.ShouldBindJSON(&presentationSettings); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		presentationSettings := &models.PresentationSettings{}
+		body, err := readProto(c, presentationSettings)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		// Only update the fields which were sent. This make is so that updating a presentation setting
+		// doesn't require all settings, you can just update 1 attribute.
+		paths, err := jsonFieldPaths(body, presentationSettings.ProtoReflect().Descriptor())
+		if err != nil {
+			writeError(c, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		// An empty mask replaces all of the settings, that's only for gRPC callers which ask for it.
+		if len(paths) == 0 {
+			writeError(c, status.Error(codes.InvalidArgument, "no fields to update"))
 			return
 		}
 
 		logrus.Info("Presentation Settings: ", presentationSettings)
 
-		leaderObj.Lock()
+		newSettings, err := leaderObj.UpdatePresentationSettings(c.Request.Context(), &models.UpdatePresentationSettingsRequest{
+			PresentationSettings: presentationSettings,
+			UpdateMask:           &fieldmaskpb.FieldMask{Paths: paths},
+			Wall:                 c.Query("wall"),
+		})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
 
-		// Merge the new settings into the current settings. This make is so that updating a presentation setting
-		// doesn't require all settings, you can just update 1 attribute.
-		if err := mergo.MergeWithOverwrite(leaderObj.PresentationSettings, presentationSettings); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		writeProto(c, newSettings)
+	}
+}
+
+func listTickers(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tickers, err := leaderObj.ListTickers(c.Request.Context(), &models.WallRequest{Wall: c.Query("wall")})
+		if err != nil {
+			writeError(c, err)
 			return
 		}
 
-		leaderObj.Unlock()
+		writeProto(c, tickers)
+	}
+}
 
-		// Update the cluster
-		leaderObj.Updates <- &models.Update{
-			UpdateType:    int32(models.UpdateTypeCluster),
-			ScreenCluster: leaderObj.CurrentScreenCluster(),
+func getTickers(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		tickers, err := leaderObj.GetTickers(c.Request.Context(), &models.WallRequest{Wall: c.Query("wall")})
+		if err != nil {
+			writeError(c, err)
+			return
 		}
 
-		c.JSON(200, gin.H{
-			"done": true,
-		})
+		writeProto(c, tickers)
 	}
 }
 
-func getCluster(leaderObj *leader.Leader) func(*gin.Context) {
+func addTicker(leaderObj *leader.Leader) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.JSON(200, gin.H{
-			"cluster": leaderObj.CurrentScreenCluster(),
+		req := &models.TickerRequest{}
+		if _, err := readProto(c, req); err != nil {
+			writeError(c, err)
+			return
+		}
+
+		// The wall can be given in the body or the query.
+		if wall := c.Query("wall"); wall != "" {
+			req.Wall = wall
+		}
+
+		ticker, err := leaderObj.AddTicker(c.Request.Context(), req)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, ticker)
+	}
+}
+
+func removeTicker(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ticker, err := leaderObj.RemoveTicker(c.Request.Context(), &models.TickerRequest{
+			Ticker: c.Param("ticker"),
+			Wall:   c.Query("wall"),
 		})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, ticker)
+	}
+}
+
+func listAnnouncements(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		announcements, err := leaderObj.ListAnnouncements(c.Request.Context(), &models.WallRequest{Wall: c.Query("wall")})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, announcements)
+	}
+}
+
+func getSnapshot(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		snapshot, err := leaderObj.GetSnapshot(c.Request.Context(), &models.WallRequest{Wall: c.Query("wall")})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, snapshot)
+	}
+}
+
+func createAnnouncement(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		announcement := &models.Announcement{}
+		if _, err := readProto(c, announcement); err != nil {
+			writeError(c, err)
+			return
+		}
+
+		// The wall can be given in the body or the query.
+		if wall := c.Query("wall"); wall != "" {
+			announcement.Wall = wall
+		}
+
+		// Tell all screen clients to update.
+		announcement, err := leaderObj.Announce(c.Request.Context(), announcement)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, announcement)
+	}
+}
+
+func getDataSourceHealth(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		health, err := leaderObj.GetDataSourceHealth(c.Request.Context(), &models.Empty{})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, health)
+	}
+}
+
+func getAccessControl(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		access, err := leaderObj.GetAccessControl(c.Request.Context(), &models.Empty{})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, access)
+	}
+}
+
+func getAuditLog(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &models.AuditLogRequest{
+			Wall:   c.Query("wall"),
+			Action: c.Query("action"),
+			User:   c.Query("user"),
+		}
+		if limit := c.Query("limit"); limit != "" {
+			n, err := strconv.ParseInt(limit, 10, 32)
+			if err != nil {
+				writeError(c, status.Errorf(codes.InvalidArgument, "invalid limit: %s", limit))
+				return
+			}
+			req.Limit = int32(n)
+		}
+
+		log, err := leaderObj.GetAuditLog(c.Request.Context(), req)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, log)
 	}
 }
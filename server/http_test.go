@@ -0,0 +1,133 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/leader"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// patchScreen sends a PATCH for a screen, returning the status code and the updated screen.
+func patchScreen(t *testing.T, srv *httptest.Server, uuid, body string) (int, *models.Screen) {
+	t.Helper()
+
+	req, err := http.NewRequest("PATCH", srv.URL+"/v1/screens/"+uuid, strings.NewReader(body))
+	if err != nil {
+		t.Error(err)
+		return 0, nil
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Error(err)
+		return 0, nil
+	}
+	defer resp.Body.Close()
+
+	screen := &models.Screen{}
+	if resp.StatusCode == http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Error(err)
+			return 0, nil
+		}
+		if err := protojson.Unmarshal(body, screen); err != nil {
+			t.Error(err)
+			return 0, nil
+		}
+	}
+	return resp.StatusCode, screen
+}
+
+func TestUpdateScreen(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	leaderObj, err := leader.New(&leader.Config{
+		TickerList:   "AAPL",
+		Source:       leader.SourceSim,
+		Presentation: &models.PresentationSettings{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := gin.New()
+	registerAPIRoutes(r, &auth.Config{}, leaderObj)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	// A screen and an observer join the wall.
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/v1/ws"
+	for _, query := range []string{"?uuid=a&width=1920&index=1", "?uuid=observer&width=800&observer=true"} {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL+query, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		if _, _, err := conn.ReadMessage(); err != nil {
+			t.Fatalf("didn't get the snapshot: %v", err)
+		}
+	}
+
+	// Only the fields which are sent change.
+	code, screen := patchScreen(t, srv, "a", `{"BezelLeft": 10}`)
+	if code != http.StatusOK {
+		t.Fatalf("PATCH screen = %d, want %d", code, http.StatusOK)
+	}
+	if screen.BezelLeft != 10 || screen.Width != 1920 || screen.Index != 1 {
+		t.Errorf("patched screen = %v, want the bezel changed and the rest kept", screen)
+	}
+
+	// Observers aren't part of the cluster, but can still be updated.
+	code, screen = patchScreen(t, srv, "observer", `{"Height": 600}`)
+	if code != http.StatusOK {
+		t.Fatalf("PATCH observer = %d, want %d", code, http.StatusOK)
+	}
+	if screen.Height != 600 || screen.Width != 800 || !screen.Observer {
+		t.Errorf("patched observer = %v, want the height changed and the rest kept", screen)
+	}
+
+	// Patches of different fields at the same time don't undo each other.
+	var wg sync.WaitGroup
+	for _, field := range []string{"BezelRight", "Gap"} {
+		wg.Add(1)
+		go func(field string) {
+			defer wg.Done()
+			for i := 1; i <= 20; i++ {
+				if code, _ := patchScreen(t, srv, "a", fmt.Sprintf(`{%q: %d}`, field, i)); code != http.StatusOK {
+					t.Errorf("PATCH %s = %d, want %d", field, code, http.StatusOK)
+				}
+			}
+		}(field)
+	}
+	wg.Wait()
+
+	cluster, err := leaderObj.CurrentScreenCluster(models.DefaultWall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range cluster.Screens {
+		if s.UUID == "a" && (s.BezelLeft != 10 || s.BezelRight != 20 || s.Gap != 20) {
+			t.Errorf("screen = %v, want every patch applied", s)
+		}
+	}
+
+	// Nothing to change, unknown fields and unknown screens.
+	for uuid, body := range map[string]string{"a": `{}`, "observer": `{"Nope": 1}`} {
+		if code, _ := patchScreen(t, srv, uuid, body); code != http.StatusBadRequest {
+			t.Errorf("PATCH %s %s = %d, want %d", uuid, body, code, http.StatusBadRequest)
+		}
+	}
+	if code, _ := patchScreen(t, srv, "missing", `{"Gap": 1}`); code != http.StatusNotFound {
+		t.Errorf("PATCH missing screen = %d, want %d", code, http.StatusNotFound)
+	}
+}
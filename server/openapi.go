@@ -0,0 +1,263 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// openAPIVersion is the version of the OpenAPI specification the document follows.
+const openAPIVersion = "3.0.3"
+
+// ginParam matches the path parameters of a gin path, eg: :ticker
+// nolint:gochecknoglobals // compiled once.
+var ginParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// getOpenAPIDocument serves the OpenAPI document describing the routes.
+func getOpenAPIDocument(routes []route) gin.HandlerFunc {
+	// The routes don't change, so the document is only made once.
+	data, err := json.MarshalIndent(openAPIDocument(routes), "", "  ")
+
+	return func(c *gin.Context) {
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		c.Data(http.StatusOK, "application/json", data)
+	}
+}
+
+// openAPIDocument describes the routes as an OpenAPI document. The schemas are made from the proto
+// message descriptors, following the protojson mapping the API uses.
+func openAPIDocument(routes []route) map[string]interface{} {
+	schemas := map[string]interface{}{
+		"Error": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"error": map[string]interface{}{"type": "string", "description": "What went wrong."},
+				"code":  map[string]interface{}{"type": "string", "description": "The gRPC status code, eg: NotFound"},
+			},
+		},
+	}
+
+	paths := map[string]interface{}{}
+	for _, rt := range routes {
+		path := ginParam.ReplaceAllString(rt.path, "{$1}")
+		item, ok := paths[path].(map[string]interface{})
+		if !ok {
+			item = map[string]interface{}{}
+			paths[path] = item
+		}
+		item[strings.ToLower(rt.method)] = openAPIOperation(rt, schemas)
+	}
+
+	return map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":       "Polygon.io Ticker Wall",
+			"description": "REST API of the ticker wall leader. Each route mirrors an RPC of the Leader gRPC service.",
+			"version":     "v1",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "Required when the leader has tokens, unless it has a default role. Can also be given as the access_token query parameter.",
+				},
+			},
+		},
+		// The token is optional, it's only needed when the leader has tokens and no default role.
+		"security": []interface{}{
+			map[string]interface{}{"bearerAuth": []interface{}{}},
+			map[string]interface{}{},
+		},
+	}
+}
+
+func openAPIOperation(rt route, schemas map[string]interface{}) map[string]interface{} {
+	var params []interface{}
+	for _, match := range ginParam.FindAllStringSubmatch(rt.path, -1) {
+		params = append(params, map[string]interface{}{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+	if rt.wall {
+		params = append(params, map[string]interface{}{
+			"name":        "wall",
+			"in":          "query",
+			"description": "Name of the wall, the default wall when it's not given.",
+			"schema":      map[string]interface{}{"type": "string"},
+		})
+	}
+	for _, q := range rt.query {
+		params = append(params, map[string]interface{}{
+			"name":        q.name,
+			"in":          "query",
+			"description": q.description,
+			"schema":      map[string]interface{}{"type": q.kind},
+		})
+	}
+
+	response := map[string]interface{}{
+		"description": "A " + messageName(rt.response.ProtoReflect().Descriptor()) + " message.",
+		"content":     jsonContent(messageRef(rt.response.ProtoReflect().Descriptor(), schemas)),
+	}
+	status := "200"
+	if rt.stream {
+		response["description"] = "Switches to a WebSocket, which streams a " +
+			messageName(rt.response.ProtoReflect().Descriptor()) + " message per text message."
+		status = "101"
+	}
+
+	op := map[string]interface{}{
+		"operationId": operationID(rt),
+		"summary":     rt.summary,
+		"description": "Mirrors the " + rt.rpc + " RPC, and needs a role with it's permission.",
+		"responses": map[string]interface{}{
+			status: response,
+			"default": map[string]interface{}{
+				"description": "An error.",
+				"content":     jsonContent(map[string]interface{}{"$ref": "#/components/schemas/Error"}),
+			},
+		},
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	if rt.request != nil {
+		op["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content":  jsonContent(messageRef(rt.request.ProtoReflect().Descriptor(), schemas)),
+		}
+	}
+	if rt.deprecated {
+		op["deprecated"] = true
+	}
+
+	return op
+}
+
+// operationID is a unique name for a route, eg: PATCH /v1/settings is patchV1Settings.
+func operationID(rt route) string {
+	id := strings.ToLower(rt.method)
+	for _, part := range strings.FieldsFunc(rt.path, func(r rune) bool { return r == '/' || r == ':' }) {
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id
+}
+
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+// messageName is the name of a message's schema. The models package is left off.
+func messageName(md protoreflect.MessageDescriptor) string {
+	return strings.TrimPrefix(string(md.FullName()), string(md.ParentFile().Package())+".")
+}
+
+// messageRef adds a message's schema, and the schemas of the messages it uses, to the schemas. A
+// reference to the message's schema is returned.
+func messageRef(md protoreflect.MessageDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	// Well known types have their own JSON mapping.
+	switch md.FullName() {
+	case "google.protobuf.FieldMask":
+		return map[string]interface{}{"type": "string", "description": "Comma separated field paths."}
+	case "google.protobuf.Timestamp":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration":
+		return map[string]interface{}{"type": "string"}
+	case "google.protobuf.Any":
+		return map[string]interface{}{
+			"type":        "object",
+			"description": "A message, with it's type in the @type field.",
+			"properties": map[string]interface{}{
+				"@type": map[string]interface{}{"type": "string"},
+			},
+			"additionalProperties": true,
+		}
+	}
+
+	name := messageName(md)
+	ref := map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+
+	// Added before the fields, so messages which use themselves don't loop forever.
+	properties := map[string]interface{}{}
+	schemas[name] = map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[fd.JSONName()] = fieldSchema(fd, schemas)
+	}
+
+	return ref
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	switch {
+	case fd.IsMap():
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": kindSchema(fd.MapValue(), schemas),
+		}
+	case fd.IsList():
+		return map[string]interface{}{
+			"type":  "array",
+			"items": kindSchema(fd, schemas),
+		}
+	}
+	return kindSchema(fd, schemas)
+}
+
+// kindSchema is the schema of a single value of a field, following the protojson mapping.
+func kindSchema(fd protoreflect.FieldDescriptor, schemas map[string]interface{}) map[string]interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// 64 bit integers are strings in protojson, numbers are accepted in requests too.
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case protoreflect.StringKind:
+		return map[string]interface{}{"type": "string"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]interface{}, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return map[string]interface{}{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(fd.Message(), schemas)
+	}
+	return map[string]interface{}{}
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+func TestRoutesMirrorEveryRPC(t *testing.T) {
+	mirrored := map[string]bool{}
+	for _, rt := range apiRoutes() {
+		mirrored[rt.rpc] = true
+	}
+
+	methods := models.File_models_proto.Services().ByName("Leader").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		name := string(methods.Get(i).Name())
+		if !mirrored[name] {
+			t.Errorf("the %s RPC has no REST route", name)
+		}
+		delete(mirrored, name)
+	}
+
+	for name := range mirrored {
+		t.Errorf("route mirrors %s, which isn't a Leader RPC", name)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	doc := openAPIDocument(apiRoutes())
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+
+	// Every reference is to a schema in the document.
+	for _, part := range strings.Split(string(data), `"$ref":"#/components/schemas/`)[1:] {
+		name := part[:strings.IndexByte(part, '"')]
+		if _, ok := schemas[name]; !ok {
+			t.Errorf("reference to missing schema %s", name)
+		}
+	}
+
+	// Every operation has it's own ID.
+	ids := map[string]bool{}
+	for path, item := range doc["paths"].(map[string]interface{}) {
+		if strings.Contains(path, ":") {
+			t.Errorf("path %s has a gin parameter", path)
+		}
+		for method, op := range item.(map[string]interface{}) {
+			id := op.(map[string]interface{})["operationId"].(string)
+			if ids[id] {
+				t.Errorf("%s %s has a duplicate operationId %s", method, path, id)
+			}
+			ids[id] = true
+		}
+	}
+
+	// Fields follow the protojson mapping.
+	settings := schemas["PresentationSettings"].(map[string]interface{})["properties"].(map[string]interface{})
+	if got := settings["UpColor"].(map[string]interface{})["$ref"]; got != "#/components/schemas/RGBA" {
+		t.Errorf("UpColor is %v, want a reference to RGBA", got)
+	}
+	announcement := schemas["Announcement"].(map[string]interface{})["properties"].(map[string]interface{})
+	if got := announcement["ShowAtTimestampMS"].(map[string]interface{})["type"]; got != "string" {
+		t.Errorf("int64 ShowAtTimestampMS is %v, want a string", got)
+	}
+	tickers := schemas["Tickers"].(map[string]interface{})["properties"].(map[string]interface{})
+	if got := tickers["Tickers"].(map[string]interface{})["type"]; got != "array" {
+		t.Errorf("repeated Tickers is %v, want an array", got)
+	}
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/leader"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// route is an endpoint of the REST API. Each route mirrors a Leader RPC, taking the RPCs request
+// message as it's body and responding with the RPCs response message, both as protojson. Routes
+// are registered with gin and described in the OpenAPI document from the same table, so the two
+// can't drift apart.
+type route struct {
+	method  string
+	path    string // Gin path, eg: /v1/tickers/:ticker
+	rpc     string // The Leader RPC this route mirrors.
+	summary string
+
+	// wall is true when the route takes the ?wall= query parameter.
+	wall  bool
+	query []queryParam
+
+	request  proto.Message // Nil when there's no request body.
+	response proto.Message
+
+	// stream is true when the route is a WebSocket streaming the response messages.
+	stream bool
+
+	// deprecated routes are kept so existing scripts keep working.
+	deprecated bool
+
+	handler func(*leader.Leader) gin.HandlerFunc
+}
+
+// queryParam is a query parameter of a route, other than wall.
+type queryParam struct {
+	name        string
+	kind        string // The OpenAPI type, eg: integer
+	description string
+}
+
+// apiRoutes are the routes of the REST API.
+func apiRoutes() []route {
+	return []route{
+		{
+			method: "GET", path: "/v1/walls", rpc: "ListWalls",
+			summary:  "List the names of the walls served by the leader.",
+			response: &models.Walls{},
+			handler:  listWalls,
+		},
+		{
+			method: "POST", path: "/v1/walls", rpc: "CreateWall",
+			summary:  "Create a wall, which starts as a copy of the default wall's settings and tickers.",
+			wall:     true,
+			request:  &models.WallRequest{},
+			response: &models.Walls{},
+			handler:  createWall,
+		},
+		{
+			method: "DELETE", path: "/v1/walls/:wall", rpc: "DeleteWall",
+			summary:  "Delete a wall, disconnecting it's screens. The default wall can't be deleted.",
+			response: &models.Walls{},
+			handler:  deleteWall,
+		},
+		{
+			method: "GET", path: "/v1/cluster", rpc: "GetScreenCluster",
+			summary:  "Get the screens and presentation settings of a wall.",
+			wall:     true,
+			response: &models.ScreenCluster{},
+			handler:  getCluster,
+		},
+		{
+			method: "PATCH", path: "/v1/screens/:uuid", rpc: "UpdateScreen",
+			summary:  "Update a screen. Only the fields which are sent are changed.",
+			wall:     true,
+			request:  &models.Screen{},
+			response: &models.Screen{},
+			handler:  updateScreen,
+		},
+		{
+			method: "DELETE", path: "/v1/screens/:uuid", rpc: "KickScreen",
+			summary:  "Disconnect a screen from it's wall.",
+			wall:     true,
+			response: &models.Screen{},
+			handler:  kickScreen,
+		},
+		{
+			method: "GET", path: "/v1/settings", rpc: "GetScreenCluster",
+			summary:  "Get the presentation settings of a wall.",
+			wall:     true,
+			response: &models.PresentationSettings{},
+			handler:  getSettings,
+		},
+		{
+			method: "PATCH", path: "/v1/settings", rpc: "UpdatePresentationSettings",
+			summary:  "Update the presentation settings of a wall. Only the fields which are sent are changed.",
+			wall:     true,
+			request:  &models.PresentationSettings{},
+			response: &models.PresentationSettings{},
+			handler:  updateSettings,
+		},
+		{
+			method: "POST", path: "/v1/presentation", rpc: "UpdatePresentationSettings",
+			summary:    "Use PATCH /v1/settings.",
+			wall:       true,
+			request:    &models.PresentationSettings{},
+			response:   &models.PresentationSettings{},
+			deprecated: true,
+			handler:    updateSettings,
+		},
+		{
+			method: "GET", path: "/v1/tickers", rpc: "ListTickers",
+			summary:  "List the tickers on a wall, without their aggregates.",
+			wall:     true,
+			response: &models.Tickers{},
+			handler:  listTickers,
+		},
+		{
+			method: "GET", path: "/v1/tickers/data", rpc: "GetTickers",
+			summary:  "Get the tickers on a wall, with their aggregates and logos.",
+			wall:     true,
+			response: &models.Tickers{},
+			handler:  getTickers,
+		},
+		{
+			method: "POST", path: "/v1/tickers", rpc: "AddTicker",
+			summary:  "Add a ticker to a wall.",
+			wall:     true,
+			request:  &models.TickerRequest{},
+			response: &models.Ticker{},
+			handler:  addTicker,
+		},
+		{
+			method: "DELETE", path: "/v1/tickers/:ticker", rpc: "RemoveTicker",
+			summary:  "Remove a ticker from a wall.",
+			wall:     true,
+			response: &models.Ticker{},
+			handler:  removeTicker,
+		},
+		{
+			method: "GET", path: "/v1/announcements", rpc: "ListAnnouncements",
+			summary:  "List the announcements of a wall which are showing, or scheduled to be shown.",
+			wall:     true,
+			response: &models.Announcements{},
+			handler:  listAnnouncements,
+		},
+		{
+			method: "GET", path: "/v1/snapshot", rpc: "GetSnapshot",
+			summary:  "Get the full state of a wall, as of it's latest update sequence.",
+			wall:     true,
+			response: &models.Snapshot{},
+			handler:  getSnapshot,
+		},
+		{
+			method: "POST", path: "/v1/announcements", rpc: "Announce",
+			summary:  "Make an announcement. Set ShowAtTimestampMS to schedule it.",
+			wall:     true,
+			request:  &models.Announcement{},
+			response: &models.Announcement{},
+			handler:  createAnnouncement,
+		},
+		{
+			method: "POST", path: "/v1/announcement", rpc: "Announce",
+			summary:    "Use POST /v1/announcements.",
+			wall:       true,
+			request:    &models.Announcement{},
+			response:   &models.Announcement{},
+			deprecated: true,
+			handler:    createAnnouncement,
+		},
+		{
+			method: "POST", path: "/v1/clock", rpc: "SyncClock",
+			summary:  "Measure a screens clock offset from the leaders clock.",
+			request:  &models.ClockSyncRequest{},
+			response: &models.ClockSyncResponse{},
+			handler:  syncClock,
+		},
+		{
+			method: "GET", path: "/v1/health", rpc: "GetDataSourceHealth",
+			summary:  "Get how the leaders market data source is doing.",
+			response: &models.DataSourceHealth{},
+			handler:  getDataSourceHealth,
+		},
+		{
+			method: "GET", path: "/v1/access", rpc: "GetAccessControl",
+			summary:  "Get the roles and users allowed to use the leader. Tokens are never included.",
+			response: &models.AccessControl{},
+			handler:  getAccessControl,
+		},
+		{
+			method: "GET", path: "/v1/audit", rpc: "GetAuditLog",
+			summary: "Get the audit log of the changes made to the walls, oldest first.",
+			query: []queryParam{
+				{name: "wall", kind: "string", description: "Only changes to this wall, every wall when it's not given."},
+				{name: "action", kind: "string", description: "Only changes made by this RPC, eg: Announce"},
+				{name: "user", kind: "string", description: "Only changes made by this user."},
+				{name: "limit", kind: "integer", description: "How many of the most recent changes to return, 100 when it's not given."},
+			},
+			response: &models.AuditLog{},
+			handler:  getAuditLog,
+		},
+		{
+			method: "GET", path: "/v1/ws", rpc: "JoinCluster",
+			summary: "Join a wall as a screen over a WebSocket. The walls tickers are sent first, then each update. " +
+				"Send a Screen message to update the screen.",
+			wall: true,
+			query: []queryParam{
+				{name: "uuid", kind: "string", description: "The screens UUID, a new one is made when it's not given."},
+				{name: "observer", kind: "boolean", description: "Watch the wall without taking a spot in the layout."},
+				{name: "width", kind: "integer", description: "Width of the screen, in pixels."},
+				{name: "height", kind: "integer", description: "Height of the screen, in pixels."},
+				{name: "index", kind: "integer", description: "Position of the screen in it's row."},
+				{name: "row", kind: "integer", description: "Row of the screen, top to bottom."},
+				{name: "bezelLeft", kind: "integer", description: "Left bezel, in pixels."},
+				{name: "bezelRight", kind: "integer", description: "Right bezel, in pixels."},
+				{name: "gap", kind: "integer", description: "Gap to the next screen, in pixels."},
+			},
+			response: &models.Update{},
+			stream:   true,
+			handler:  joinClusterWebSocket,
+		},
+	}
+}
+
+// registerAPIRoutes registers the REST API, and the OpenAPI document describing it. Each route needs
+// the permission of the RPC it mirrors, the document is public. WebSockets also need to be opened
+// from an allowed origin.
+func registerAPIRoutes(r *gin.Engine, authCfg *auth.Config, leaderObj *leader.Leader) {
+	routes := apiRoutes()
+	for _, rt := range routes {
+		handlers := []gin.HandlerFunc{authorize(authCfg, rt.rpc), rt.handler(leaderObj)}
+		if rt.stream {
+			handlers = append([]gin.HandlerFunc{checkOrigin(authCfg)}, handlers...)
+		}
+		r.Handle(rt.method, rt.path, handlers...)
+	}
+
+	r.GET("/v1/openapi.json", getOpenAPIDocument(routes))
+}
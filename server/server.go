@@ -7,7 +7,9 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/polygon-io/go-app-ticker-wall/auth"
 	leader "github.com/polygon-io/go-app-ticker-wall/leader"
+	"github.com/sirupsen/logrus"
 	tombv2 "gopkg.in/tomb.v2"
 )
 
@@ -16,12 +18,22 @@ type ServiceConfig struct {
 	GRPCPort     int
 	HTTPPort     int
 	LeaderConfig leader.Config
+	// Auth secures the gRPC and HTTP servers.
+	Auth auth.Config
 }
 
 func Run(cfg *ServiceConfig) error {
 	// Global top level context.
 	tomb, ctx := tombv2.WithContext(context.Background())
 
+	if err := cfg.Auth.Validate(); err != nil {
+		return fmt.Errorf("invalid access control: %w", err)
+	}
+	if cfg.Auth.AccessControlEnabled() && !cfg.Auth.TLSEnabled() {
+		logrus.Warn("Tokens are used without TLS, they can be read by anyone on the network.")
+	}
+	cfg.LeaderConfig.AccessControl = cfg.Auth.Describe()
+
 	// Start the ticker wall leader.
 	clusterLeader, err := leader.New(&cfg.LeaderConfig)
 	if err != nil {
@@ -34,16 +46,17 @@ func Run(cfg *ServiceConfig) error {
 
 	// Start the GRPC server.
 	tomb.Go(func() error {
-		return startGRPC(ctx, cfg.GRPCPort, clusterLeader)
+		return startGRPC(ctx, cfg.GRPCPort, &cfg.Auth, clusterLeader)
 	})
 
 	// Start the HTTP admin server.
 	tomb.Go(func() error {
-		return runHTTPServer(ctx, cfg.HTTPPort, clusterLeader)
+		return runHTTPServer(ctx, cfg.HTTPPort, &cfg.Auth, clusterLeader)
 	})
 
-	// Wait for OS signals:
-	sigs := make(chan os.Signal)
+	// Wait for OS signals. signal.Notify doesn't wait for us to receive, so the channel is buffered
+	// or a signal sent while we aren't waiting yet is dropped.
+	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	tomb.Go(func() error {
 		select {
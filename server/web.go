@@ -0,0 +1,46 @@
+package server
+
+import (
+	// We are using embed so the leader can serve the browser client without any extra files.
+	"embed"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/polygon-io/go-app-ticker-wall/fonts"
+)
+
+// nolint:gochecknoglobals // not sure how else to go about this.
+//
+//go:embed web
+var webFiles embed.FS
+
+// registerWebRoutes serves the browser client and the admin dashboard. Opening /wall in a browser
+// joins the cluster as a screen, eg: /wall?wall=lobby&index=3
+func registerWebRoutes(r *gin.Engine) {
+	r.GET("/wall", webFile("web/wall.html", "text/html; charset=utf-8"))
+	r.GET("/admin", webFile("web/admin.html", "text/html; charset=utf-8"))
+	r.GET("/admin/admin.js", webFile("web/admin.js", "application/javascript"))
+	r.GET("/wall/wall.js", webFile("web/wall.js", "application/javascript"))
+	r.GET("/wall/fonts/:face", func(c *gin.Context) {
+		// The font faces are served as <face>.ttf, eg: sans-bold.ttf
+		data := fonts.TTF(strings.TrimSuffix(c.Param("face"), ".ttf"))
+		if data == nil || !strings.HasSuffix(c.Param("face"), ".ttf") {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.Data(http.StatusOK, "font/ttf", data)
+	})
+}
+
+// webFile serves an embedded file.
+func webFile(name, contentType string) func(*gin.Context) {
+	return func(c *gin.Context) {
+		data, err := webFiles.ReadFile(name)
+		if err != nil {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.Data(http.StatusOK, contentType, data)
+	}
+}
@@ -0,0 +1,460 @@
+// Admin dashboard for the ticker wall. Everything goes through the leaders REST API, and the preview
+// is the browser client running as an observer, with the draft settings posted to it. Leaders which
+// require a token are given it with ?access_token=
+(function () {
+  "use strict";
+
+  // How often the dashboard refreshes, in ms.
+  var refreshInterval = 2000;
+
+  // The settings editor fields, see models.PresentationSettings.
+  var colorFields = ["UpColor", "DownColor", "FontColor", "TickerBoxBGColor", "BGColor"];
+  var numberFields = ["ScrollSpeed", "TickerBoxWidth", "SpacerWidth"];
+  var boolFields = ["DynamicTickerWidths"];
+  var textFields = ["SpacerText"];
+
+  var announcementTypes = ["Info", "Danger", "Success"];
+
+  var params = new URLSearchParams(window.location.search);
+  var accessToken = params.get("access_token") || "";
+
+  var state = {
+    wall: params.get("wall") || "",
+
+    // The wall's settings as the leader has them, and whether the editor has unapplied changes.
+    settings: null,
+    dirty: false
+  };
+
+  function $(id) {
+    return document.getElementById(id);
+  }
+
+  // ----- API -----
+
+  function api(method, path, body) {
+    var url = path + (path.indexOf("?") < 0 ? "?" : "&") + "wall=" + encodeURIComponent(state.wall);
+    var opts = { method: method, headers: {} };
+    if (accessToken !== "") {
+      opts.headers.Authorization = "Bearer " + accessToken;
+    }
+    if (body !== undefined) {
+      opts.headers["Content-Type"] = "application/json";
+      opts.body = JSON.stringify(body);
+    }
+
+    return fetch(url, opts).then(function (res) {
+      return res.json().then(function (data) {
+        if (!res.ok) {
+          throw new Error(data.error || res.statusText);
+        }
+        return data;
+      });
+    });
+  }
+
+  function showStatus(message, isError) {
+    var status = $("status");
+    status.textContent = message;
+    status.className = isError ? "error" : "muted";
+  }
+
+  function showError(err) {
+    showStatus(err.message || String(err), true);
+  }
+
+  // ----- Formatting -----
+
+  // int64 fields are strings in protojson.
+  function num(value) {
+    return value ? Number(value) : 0;
+  }
+
+  function el(tag, text, className) {
+    var node = document.createElement(tag);
+    if (text !== undefined && text !== null) {
+      node.textContent = text;
+    }
+    if (className) {
+      node.className = className;
+    }
+    return node;
+  }
+
+  function tableRow(cells) {
+    var tr = el("tr");
+    cells.forEach(function (cell) {
+      if (cell instanceof Node) {
+        var td = el("td");
+        td.appendChild(cell);
+        tr.appendChild(td);
+        return;
+      }
+      tr.appendChild(el("td", cell));
+    });
+    return tr;
+  }
+
+  function replaceRows(id, rows, empty) {
+    var body = $(id);
+    body.innerHTML = "";
+    rows.forEach(function (row) {
+      body.appendChild(row);
+    });
+    if (rows.length === 0) {
+      var td = el("td", empty, "muted");
+      td.colSpan = 10;
+      var tr = el("tr");
+      tr.appendChild(td);
+      body.appendChild(tr);
+    }
+  }
+
+  function ago(ms) {
+    if (!ms) {
+      return "never";
+    }
+
+    var seconds = Math.round((Date.now() - ms) / 1000);
+    if (seconds < 0) {
+      return "in " + duration(-seconds);
+    }
+    return duration(seconds) + " ago";
+  }
+
+  function duration(seconds) {
+    if (seconds < 60) {
+      return seconds + "s";
+    }
+    if (seconds < 3600) {
+      return Math.floor(seconds / 60) + "m " + (seconds % 60) + "s";
+    }
+    return Math.floor(seconds / 3600) + "h " + Math.floor((seconds % 3600) / 60) + "m";
+  }
+
+  function hex(color) {
+    color = color || {};
+    return "#" + [color.Red, color.Green, color.Blue].map(function (c) {
+      var s = (c || 0).toString(16);
+      return s.length < 2 ? "0" + s : s;
+    }).join("");
+  }
+
+  function rgba(value, alpha) {
+    return {
+      Red: parseInt(value.substr(1, 2), 16),
+      Green: parseInt(value.substr(3, 2), 16),
+      Blue: parseInt(value.substr(5, 2), 16),
+      Alpha: alpha === undefined ? 255 : alpha
+    };
+  }
+
+  // ----- Walls -----
+
+  function loadWalls() {
+    return api("GET", "/v1/walls").then(function (data) {
+      var select = $("wall");
+      var walls = data.Walls || [];
+      if (state.wall === "" && walls.length > 0) {
+        state.wall = walls[0];
+      }
+
+      select.innerHTML = "";
+      walls.forEach(function (wall) {
+        var option = el("option", wall);
+        option.value = wall;
+        option.selected = wall === state.wall;
+        select.appendChild(option);
+      });
+    });
+  }
+
+  function selectWall(wall) {
+    state.wall = wall;
+    state.settings = null;
+    state.dirty = false;
+    loadPreview();
+    refresh();
+  }
+
+  // ----- Screens and Settings -----
+
+  function loadCluster() {
+    return api("GET", "/v1/cluster").then(function (cluster) {
+      var screens = (cluster.Screens || []).slice();
+      screens.sort(function (a, b) {
+        return ((a.Row || 0) - (b.Row || 0)) || ((a.Index || 0) - (b.Index || 0));
+      });
+
+      replaceRows("screens", screens.map(function (screen) {
+        var kick = el("button", "Kick");
+        kick.type = "button";
+        kick.addEventListener("click", function () {
+          kickScreen(screen.UUID);
+        });
+
+        return tableRow([
+          screen.Row || 0,
+          screen.Index || 0,
+          (screen.Width || 0) + "x" + (screen.Height || 0),
+          (screen.BezelLeft || 0) + " / " + (screen.BezelRight || 0),
+          screen.Gap || 0,
+          (num(screen.ClockOffsetNS) / 1e6).toFixed(2) + " ms",
+          sendStats(screen.SendStats),
+          screen.UUID,
+          kick
+        ]);
+      }), "No screens connected.");
+
+      state.settings = cluster.Settings || {};
+      if (!state.dirty) {
+        fillSettings(state.settings);
+      }
+    });
+  }
+
+  // How the leader is keeping up with sending the screen updates.
+  function sendStats(stats) {
+    stats = stats || {};
+    return num(stats.Queued) + " queued, " + num(stats.Dropped) + " dropped";
+  }
+
+  // Kicked screens reconnect on their own, unless their access is removed. Needs the admin role.
+  function kickScreen(uuid) {
+    api("DELETE", "/v1/screens/" + encodeURIComponent(uuid)).then(function () {
+      showStatus("Kicked " + uuid + ".");
+      return loadCluster();
+    }).catch(showError);
+  }
+
+  function fillSettings(settings) {
+    colorFields.forEach(function (field) {
+      $(field).value = hex(settings[field]);
+    });
+    numberFields.forEach(function (field) {
+      $(field).value = settings[field] || 0;
+    });
+    boolFields.forEach(function (field) {
+      $(field).checked = !!settings[field];
+    });
+    textFields.forEach(function (field) {
+      $(field).value = settings[field] || "";
+    });
+  }
+
+  // draftSettings are the settings in the editor, in the same shape the leader takes them.
+  function draftSettings() {
+    var settings = {};
+    colorFields.forEach(function (field) {
+      var current = (state.settings || {})[field] || {};
+      settings[field] = rgba($(field).value, current.Alpha);
+    });
+    numberFields.forEach(function (field) {
+      settings[field] = parseInt($(field).value, 10) || 0;
+    });
+    boolFields.forEach(function (field) {
+      settings[field] = $(field).checked;
+    });
+    textFields.forEach(function (field) {
+      settings[field] = $(field).value;
+    });
+    return settings;
+  }
+
+  function onSettingsChanged() {
+    state.dirty = true;
+    postPreview(draftSettings());
+  }
+
+  function applySettings(event) {
+    event.preventDefault();
+    api("PATCH", "/v1/settings", draftSettings()).then(function () {
+      state.dirty = false;
+      postPreview(null);
+      showStatus("Presentation settings applied.");
+      return loadCluster();
+    }).catch(showError);
+  }
+
+  function resetSettings() {
+    state.dirty = false;
+    postPreview(null);
+    if (state.settings) {
+      fillSettings(state.settings);
+    }
+  }
+
+  // ----- Preview -----
+
+  function loadPreview() {
+    var src = "/wall?observer=true&wall=" + encodeURIComponent(state.wall);
+    if (accessToken !== "") {
+      src += "&access_token=" + encodeURIComponent(accessToken);
+    }
+    $("preview").src = src;
+  }
+
+  function postPreview(settings) {
+    var frame = $("preview").contentWindow;
+    if (frame) {
+      frame.postMessage({ type: "preview", settings: settings }, window.location.origin);
+    }
+  }
+
+  // ----- Tickers -----
+
+  function loadTickers() {
+    return api("GET", "/v1/tickers").then(function (data) {
+      var tickers = (data.Tickers || []).slice();
+      tickers.sort(function (a, b) {
+        return (a.Index || 0) - (b.Index || 0);
+      });
+
+      replaceRows("tickers", tickers.map(function (ticker) {
+        var change = ticker.PriceChangePercentage || 0;
+        var remove = el("button", "Remove");
+        remove.type = "button";
+        remove.addEventListener("click", function () {
+          removeTicker(ticker.Ticker);
+        });
+
+        return tableRow([
+          ticker.Ticker,
+          ticker.CompanyName || "",
+          (ticker.Price || 0).toFixed(2),
+          el("span", (change < 0 ? "" : "+") + change.toFixed(2) + "%", change < 0 ? "down" : "up"),
+          remove
+        ]);
+      }), "No tickers.");
+    });
+  }
+
+  function addTicker(event) {
+    event.preventDefault();
+    var input = $("new-ticker");
+    var ticker = input.value.trim().toUpperCase();
+    if (ticker === "") {
+      return;
+    }
+
+    api("POST", "/v1/tickers", { Ticker: ticker }).then(function () {
+      input.value = "";
+      showStatus("Added " + ticker + ".");
+      return loadTickers();
+    }).catch(showError);
+  }
+
+  function removeTicker(ticker) {
+    api("DELETE", "/v1/tickers/" + encodeURIComponent(ticker)).then(function () {
+      showStatus("Removed " + ticker + ".");
+      return loadTickers();
+    }).catch(showError);
+  }
+
+  // ----- Announcements -----
+
+  function loadAnnouncements() {
+    return api("GET", "/v1/announcements").then(function (data) {
+      var announcements = (data.Announcements || []).slice();
+      announcements.sort(function (a, b) {
+        return num(a.ShowAtTimestampMS) - num(b.ShowAtTimestampMS);
+      });
+
+      replaceRows("announcements", announcements.map(function (announcement) {
+        var showAt = num(announcement.ShowAtTimestampMS);
+        return tableRow([
+          announcement.Message,
+          announcementTypes[announcement.AnnouncementType || 0] || "Info",
+          showAt > Date.now() ? new Date(showAt).toLocaleString() + " ( " + ago(showAt) + " )" : "showing",
+          (num(announcement.LifespanMS) / 1000) + "s"
+        ]);
+      }), "No pending announcements.");
+    });
+  }
+
+  function announce(event) {
+    event.preventDefault();
+
+    var announcement = {
+      Message: $("Message").value,
+      AnnouncementType: parseInt($("AnnouncementType").value, 10),
+      Animation: parseInt($("Animation").value, 10),
+      LifespanMS: Math.round(parseFloat($("Lifespan").value) * 1000) || 2000
+    };
+
+    // Scheduled announcements are held by the leader until it's time to show them.
+    var showAt = $("ShowAt").value;
+    if (showAt !== "") {
+      announcement.ShowAtTimestampMS = new Date(showAt).getTime();
+    }
+
+    api("POST", "/v1/announcements", announcement).then(function () {
+      $("Message").value = "";
+      $("ShowAt").value = "";
+      showStatus(showAt !== "" ? "Announcement scheduled." : "Announcement sent.");
+      return loadAnnouncements();
+    }).catch(showError);
+  }
+
+  // ----- Data Source -----
+
+  function loadHealth() {
+    return api("GET", "/v1/health").then(function (health) {
+      var uptime = (Date.now() - (num(health.StartedMS) || Date.now())) / 1000;
+      var rate = uptime > 0 ? num(health.PriceUpdates) / uptime : 0;
+
+      var rows = [
+        ["Source", health.Source || ""],
+        ["Streaming", el("span", health.Streaming ? "yes" : "no", health.Streaming ? "up" : "down")],
+        ["Started", ago(num(health.StartedMS))],
+        ["Price Updates", num(health.PriceUpdates) + " ( " + rate.toFixed(1) + " / s )"],
+        ["Last Price Update", ago(num(health.LastPriceUpdateMS))],
+        ["Last Aggs Refresh", ago(num(health.LastAggsRefreshMS))],
+        ["Last Details Refresh", ago(num(health.LastDetailsRefreshMS))]
+      ];
+      if (health.LastError) {
+        rows.push(["Last Error", el("span", health.LastError + " ( " + ago(num(health.LastErrorMS)) + " )", "error")]);
+      }
+
+      replaceRows("health", rows.map(tableRow), "");
+    });
+  }
+
+  // ----- Refresh -----
+
+  function refresh() {
+    return Promise.all([
+      loadCluster(),
+      loadTickers(),
+      loadAnnouncements(),
+      loadHealth()
+    ]).catch(showError);
+  }
+
+  function start() {
+    $("wall").addEventListener("change", function (event) {
+      selectWall(event.target.value);
+    });
+    $("add-ticker").addEventListener("submit", addTicker);
+    $("settings").addEventListener("submit", applySettings);
+    $("settings").addEventListener("input", onSettingsChanged);
+    $("settings").addEventListener("change", onSettingsChanged);
+    $("reset-settings").addEventListener("click", resetSettings);
+    $("announce").addEventListener("submit", announce);
+
+    // Keep the preview showing the draft settings after it reconnects or reloads.
+    $("preview").addEventListener("load", function () {
+      if (state.dirty) {
+        postPreview(draftSettings());
+      }
+    });
+
+    loadWalls().catch(showError).then(function () {
+      loadPreview();
+      refresh();
+      setInterval(refresh, refreshInterval);
+    });
+  }
+
+  start();
+})();
@@ -0,0 +1,914 @@
+// Browser client for the ticker wall. This joins the cluster as a screen over a WebSocket, and draws
+// the same frames the GUI does onto a canvas. The layout math is ported from the layout and models
+// packages, and the drawing from gui/frame, so a browser screen lines up with the GUI screens.
+//
+// There is no build step, and it sticks to widely supported browser features so it runs on smart
+// TVs and kiosk browsers.
+//
+// With ?observer=true it watches the wall instead, following the tape as it's shown on the first
+// screen, without taking up a spot in the layout. The admin dashboard uses this for it's preview.
+// Leaders which require a token are given it with ?access_token=
+(function () {
+  "use strict";
+
+  // Update types, see models/constants.go.
+  var UpdateTypeCluster = 1;
+  var UpdateTypeTickerAdded = 2;
+  var UpdateTypeTickerRemoved = 3;
+  var UpdateTypeTickerUpdate = 4;
+  var UpdateTypeAnnouncement = 5;
+  var UpdateTypePrice = 6;
+  var UpdatePresentationSettings = 7;
+  var UpdateTypeSnapshot = 8;
+
+  // Announcement types and animations, see models/constants.go.
+  var AnnouncementTypeDanger = 1;
+  var AnnouncementTypeSuccess = 2;
+  var AnnouncementAnimationBounce = 1;
+  var AnnouncementAnimationEase = 2;
+  var AnnouncementAnimationBack = 3;
+
+  // Ticker box settings, see layout/ticker-box.go.
+  var TickerBoxHeight = 240;
+  var TickerBoxMargin = 30;
+  var TickerBoxPadding = 50;
+  var TickerBoxBorderRadius = 8;
+  var GraphSize = 180;
+  var GraphOffset = 400;
+  var GraphSpacing = 40;
+  var UpperRowFont = { face: "sans-bold", size: 96 };
+  var BottomRowFont = { face: "sans-light", size: 58 };
+  var MaxCompanyNameCharacters = 14;
+  var graphViewportPercentage = 0.04;
+
+  // How often the clock is synced with the leader, and how many samples are taken each time.
+  var clockSyncInterval = 10000;
+  var clockSyncSamples = 5;
+
+  // How long to wait before reconnecting to the leader.
+  var reconnectDelay = 1000;
+
+  var params = new URLSearchParams(window.location.search);
+  var accessToken = params.get("access_token") || "";
+
+  var state = {
+    screen: {
+      UUID: params.get("uuid") || newUUID(),
+      Wall: params.get("wall") || "",
+      Index: intParam("index"),
+      Row: intParam("row"),
+      BezelLeft: intParam("bezelLeft"),
+      BezelRight: intParam("bezelRight"),
+      Gap: intParam("gap"),
+      Observer: params.get("observer") === "true",
+      Width: 0,
+      Height: 0
+    },
+    connected: false,
+    socket: null,
+    tickers: [],
+    cluster: null,
+    announcements: [],
+
+    // The sequence of the last update of the wall we have, and if we are fetching a snapshot to
+    // catch up on updates we missed.
+    sequence: 0,
+    resyncing: false,
+
+    // Draft settings from the admin dashboard, drawn over the clusters settings.
+    preview: null,
+
+    // Our clocks offset from the leaders clock, and the round trip it was measured with, in ms.
+    clockOffset: 0,
+    clockRoundTrip: 0
+  };
+
+  var canvas = document.getElementById("wall");
+  var ctx = canvas.getContext("2d");
+
+  function intParam(name) {
+    var value = parseInt(params.get(name), 10);
+    return isNaN(value) ? 0 : value;
+  }
+
+  function newUUID() {
+    return "xxxxxxxx-xxxx-4xxx-yxxx-xxxxxxxxxxxx".replace(/[xy]/g, function (c) {
+      var r = Math.random() * 16 | 0;
+      return (c === "x" ? r : (r & 0x3 | 0x8)).toString(16);
+    });
+  }
+
+  // now is the current time on the leaders clock, in ms.
+  function now() {
+    return Date.now() + state.clockOffset;
+  }
+
+  // int64 fields are strings in protojson.
+  function num(value) {
+    return value ? Number(value) : 0;
+  }
+
+  // ----- Connection -----
+
+  function connect() {
+    resizeCanvas();
+
+    var query = new URLSearchParams();
+    query.set("uuid", state.screen.UUID);
+    query.set("wall", state.screen.Wall);
+    query.set("index", state.screen.Index);
+    query.set("row", state.screen.Row);
+    query.set("width", state.screen.Width);
+    query.set("height", state.screen.Height);
+    query.set("bezelLeft", state.screen.BezelLeft);
+    query.set("bezelRight", state.screen.BezelRight);
+    query.set("gap", state.screen.Gap);
+    query.set("observer", state.screen.Observer);
+    if (accessToken !== "") {
+      query.set("access_token", accessToken);
+    }
+
+    var scheme = window.location.protocol === "https:" ? "wss:" : "ws:";
+    var socket = new WebSocket(scheme + "//" + window.location.host + "/v1/ws?" + query.toString());
+    state.socket = socket;
+
+    socket.onopen = function () {
+      state.connected = true;
+    };
+    socket.onmessage = function (event) {
+      processUpdate(JSON.parse(event.data));
+    };
+    socket.onclose = function () {
+      state.connected = false;
+      state.socket = null;
+
+      // We are sent a snapshot of the wall when we re-join, which replaces our state.
+      setTimeout(connect, reconnectDelay);
+    };
+  }
+
+  function processUpdate(update) {
+    if (update.UpdateType === UpdateTypeSnapshot) {
+      applySnapshot(update.Snapshot);
+      return;
+    }
+
+    // Updates of the wall are numbered, see client/sync.go. Price updates aren't.
+    var sequence = num(update.Sequence);
+    if (sequence !== 0) {
+      // Skip updates the snapshot already has, or will have.
+      if (state.resyncing || sequence <= state.sequence) {
+        return;
+      }
+      if (sequence !== state.sequence + 1) {
+        resync();
+        return;
+      }
+      state.sequence = sequence;
+    }
+
+    switch (update.UpdateType) {
+      case UpdateTypeCluster:
+        state.cluster = update.ScreenCluster;
+        break;
+      case UpdateTypeTickerAdded:
+      case UpdateTypeTickerUpdate:
+        tickerAdded(update.Ticker);
+        break;
+      case UpdateTypeTickerRemoved:
+        tickerRemoved(update.Ticker);
+        break;
+      case UpdateTypePrice:
+        tickerPriceUpdate(update.PriceUpdate);
+        break;
+      case UpdateTypeAnnouncement:
+        state.announcements.push(update.Announcement);
+        break;
+      case UpdatePresentationSettings:
+        if (state.cluster) {
+          state.cluster.Settings = update.PresentationSettings;
+        }
+        break;
+    }
+  }
+
+  function tickerAdded(ticker) {
+    var replaced = false;
+    for (var i = 0; i < state.tickers.length; i++) {
+      if (state.tickers[i].Ticker === ticker.Ticker) {
+        state.tickers[i] = ticker;
+        replaced = true;
+        break;
+      }
+    }
+    if (!replaced) {
+      state.tickers.push(ticker);
+    }
+
+    tickerPriceUpdate({ Ticker: ticker.Ticker, Price: ticker.Price });
+    sortAndTagTickers();
+  }
+
+  function tickerRemoved(ticker) {
+    state.tickers = state.tickers.filter(function (t) {
+      return t.Ticker !== ticker.Ticker;
+    });
+    sortAndTagTickers();
+  }
+
+  function tickerPriceUpdate(update) {
+    state.tickers.forEach(function (t) {
+      if (t.Ticker === update.Ticker) {
+        setTickerPrice(t, update.Price);
+      }
+    });
+  }
+
+  function setTickerPrice(ticker, price) {
+    ticker.Price = price || 0;
+    ticker.PriceChangePercentage = ((ticker.Price / (ticker.PreviousClosePrice || 0)) - 1) * 100;
+  }
+
+  // applySnapshot replaces our state with a snapshot of the wall.
+  function applySnapshot(snapshot) {
+    state.sequence = num(snapshot.Sequence);
+    state.cluster = snapshot.ScreenCluster;
+    state.tickers = snapshot.Tickers || [];
+    state.tickers.forEach(function (t) {
+      setTickerPrice(t, t.Price);
+    });
+    sortAndTagTickers();
+
+    // Announcements don't have an ID, skip the ones we already have.
+    (snapshot.Announcements || []).forEach(function (announcement) {
+      var shown = state.announcements.some(function (a) {
+        return a.ShowAtTimestampMS === announcement.ShowAtTimestampMS && a.Message === announcement.Message;
+      });
+      if (!shown) {
+        state.announcements.push(announcement);
+      }
+    });
+  }
+
+  // resync catches up on updates we missed, with a snapshot of the wall. If it fails, the next
+  // update tries again.
+  function resync() {
+    state.resyncing = true;
+
+    var xhr = new XMLHttpRequest();
+    xhr.open("GET", "/v1/snapshot?wall=" + encodeURIComponent(state.screen.Wall));
+    if (accessToken !== "") {
+      xhr.setRequestHeader("Authorization", "Bearer " + accessToken);
+    }
+    xhr.onload = function () {
+      state.resyncing = false;
+      if (xhr.status !== 200) {
+        return;
+      }
+
+      // We may have re-joined, and been sent a newer one.
+      var snapshot = JSON.parse(xhr.responseText);
+      if (num(snapshot.Sequence) >= state.sequence) {
+        applySnapshot(snapshot);
+      }
+    };
+    xhr.onerror = function () {
+      state.resyncing = false;
+    };
+    xhr.send();
+  }
+
+  function sortAndTagTickers() {
+    state.tickers.sort(function (a, b) {
+      return a.Ticker < b.Ticker ? -1 : (a.Ticker > b.Ticker ? 1 : 0);
+    });
+    state.tickers.forEach(function (t, i) {
+      t.Index = i;
+    });
+  }
+
+  // sendScreenUpdate lets the leader know our screen has changed, eg: it was resized.
+  function sendScreenUpdate() {
+    if (state.socket && state.connected) {
+      state.socket.send(JSON.stringify(state.screen));
+    }
+  }
+
+  // ----- Clock sync, NTP style. See client/clock.go. -----
+
+  function syncClock() {
+    var best = null;
+    var samples = 0;
+
+    // send sends our current offset, the leader keeps the last one it was sent.
+    function send(sentAt, onload) {
+      var xhr = new XMLHttpRequest();
+      xhr.open("POST", "/v1/clock");
+      if (accessToken !== "") {
+        xhr.setRequestHeader("Authorization", "Bearer " + accessToken);
+      }
+      if (onload) {
+        xhr.onload = function () {
+          onload(xhr);
+        };
+      }
+      xhr.send(JSON.stringify({
+        ScreenUUID: state.screen.UUID,
+        ClientTransmitNS: String(sentAt * 1e6),
+        ClockOffsetNS: String(Math.round(state.clockOffset * 1e6)),
+        ClockRoundTripNS: String(Math.round(state.clockRoundTrip * 1e6))
+      }));
+    }
+
+    function sample() {
+      var sentAt = Date.now();
+      send(sentAt, function (xhr) {
+        var receivedAt = Date.now();
+        if (xhr.status === 200) {
+          var res = JSON.parse(xhr.responseText);
+          var leaderReceive = num(res.LeaderReceiveNS) / 1e6;
+          var leaderTransmit = num(res.LeaderTransmitNS) / 1e6;
+
+          var roundTrip = (receivedAt - sentAt) - (leaderTransmit - leaderReceive);
+          var offset = ((leaderReceive - sentAt) + (leaderTransmit - receivedAt)) / 2;
+          if (best === null || roundTrip < best.roundTrip) {
+            best = { offset: offset, roundTrip: roundTrip };
+          }
+        }
+
+        samples++;
+        if (samples < clockSyncSamples) {
+          sample();
+        } else if (best !== null) {
+          state.clockOffset = best.offset;
+          state.clockRoundTrip = best.roundTrip;
+
+          // Report the new offset right away, rather than with the next sync.
+          send(Date.now(), null);
+        }
+      });
+    }
+
+    sample();
+  }
+
+  // ----- Layout, see models/scroll.go, models/ticker-layout.go and layout/screen.go. -----
+
+  function targetVelocity(settings) {
+    var speed = settings.ScrollSpeed || 0;
+    return speed <= 0 ? 0 : 1 / speed;
+  }
+
+  function scrollOffset(settings, t) {
+    var velocity = targetVelocity(settings);
+    var epoch = settings.ScrollEpoch;
+    if (!epoch) {
+      return t * velocity;
+    }
+
+    var elapsed = t - num(epoch.TimestampMS);
+    var offset = epoch.Offset || 0;
+    var duration = num(epoch.EaseDurationMS);
+    var startVelocity = epoch.StartVelocity || 0;
+    if (duration <= 0 || elapsed <= 0) {
+      return offset + (velocity * elapsed);
+    }
+
+    if (elapsed < duration) {
+      var acceleration = (velocity - startVelocity) / duration;
+      return offset + (startVelocity * elapsed) + (acceleration * elapsed * elapsed / 2);
+    }
+
+    var easedDistance = (startVelocity + velocity) / 2 * duration;
+    return offset + easedDistance + (velocity * (elapsed - duration));
+  }
+
+  function wrapOffset(offset, tapeWidth) {
+    if (tapeWidth <= 0) {
+      return 0;
+    }
+    offset = offset % tapeWidth;
+    return offset < 0 ? offset + tapeWidth : offset;
+  }
+
+  function tickersWidth(settings, tickerCount) {
+    if (settings.TickerLayout) {
+      return settings.TickerLayout.TapeWidth || 0;
+    }
+    return tickerCount * (settings.TickerBoxWidth || 0);
+  }
+
+  function tapeWidth(settings, tickerCount) {
+    return tickersWidth(settings, tickerCount) + (settings.SpacerWidth || 0);
+  }
+
+  // tickerBox gets the position of a ticker on the tape, null if it isn't in the layout yet.
+  function tickerBox(settings, ticker) {
+    if (!settings.TickerLayout) {
+      return { offset: (ticker.Index || 0) * (settings.TickerBoxWidth || 0), width: settings.TickerBoxWidth || 0 };
+    }
+
+    var boxes = settings.TickerLayout.Boxes || [];
+    for (var i = 0; i < boxes.length; i++) {
+      if (boxes[i].Ticker === ticker.Ticker) {
+        return { offset: boxes[i].Offset || 0, width: boxes[i].Width || 0 };
+      }
+    }
+    return null;
+  }
+
+  function tileOffsets(offset, width, tape, viewportWidth) {
+    if (tape <= 0 || width <= 0) {
+      return [];
+    }
+
+    offset = wrapOffset(offset, tape);
+    if (offset + width > tape) {
+      offset -= tape;
+    }
+
+    var offsets = [];
+    for (; offset < viewportWidth; offset += tape) {
+      if (offset + width > 0) {
+        offsets.push(offset);
+      }
+    }
+    return offsets;
+  }
+
+  function rows(cluster) {
+    var screens = (cluster.Screens || []).slice();
+    screens.sort(function (a, b) {
+      if ((a.Row || 0) !== (b.Row || 0)) {
+        return (a.Row || 0) - (b.Row || 0);
+      }
+      return (a.Index || 0) - (b.Index || 0);
+    });
+
+    var res = [];
+    screens.forEach(function (screen, i) {
+      if (i === 0 || (screen.Row || 0) !== (screens[i - 1].Row || 0)) {
+        res.push([]);
+      }
+      res[res.length - 1].push(screen);
+    });
+    return res;
+  }
+
+  function screenRowOffset(row, uuid) {
+    var offset = 0;
+    for (var i = 0; i < row.length; i++) {
+      var screen = row[i];
+      if (i > 0) {
+        offset += screen.BezelLeft || 0;
+      }
+      if (screen.UUID === uuid) {
+        return { offset: offset, found: true };
+      }
+      offset += (screen.Width || 0) + (screen.BezelRight || 0) + (screen.Gap || 0);
+    }
+    return { offset: offset, found: false };
+  }
+
+  function rowWidth(row) {
+    if (row.length === 0) {
+      return 0;
+    }
+    var last = row[row.length - 1];
+    return screenRowOffset(row, last.UUID).offset + (last.Width || 0);
+  }
+
+  function rowHeight(row) {
+    var height = 0;
+    row.forEach(function (screen) {
+      height = Math.max(height, screen.Height || 0);
+    });
+    return height;
+  }
+
+  function screenGlobalOffset(cluster, uuid) {
+    var offset = 0;
+    var all = rows(cluster);
+    for (var i = 0; i < all.length; i++) {
+      var res = screenRowOffset(all[i], uuid);
+      if (res.found) {
+        return offset + res.offset;
+      }
+      offset += rowWidth(all[i]);
+    }
+    return offset;
+  }
+
+  function screenPosition(cluster, uuid) {
+    var y = 0;
+    var all = rows(cluster);
+    for (var i = 0; i < all.length; i++) {
+      var res = screenRowOffset(all[i], uuid);
+      if (res.found) {
+        return { x: res.offset, y: y };
+      }
+      y += rowHeight(all[i]);
+    }
+    return { x: 0, y: y };
+  }
+
+  // viewUUID is the screen we draw. Observers aren't in the layout, so they draw the first screen.
+  function viewUUID(cluster) {
+    if (!state.screen.Observer) {
+      return state.screen.UUID;
+    }
+
+    var all = rows(cluster);
+    if (all.length === 0 || all[0].length === 0) {
+      return state.screen.UUID;
+    }
+    return all[0][0].UUID;
+  }
+
+  function canvasSize(cluster) {
+    var width = 0;
+    var height = 0;
+    rows(cluster).forEach(function (row) {
+      width = Math.max(width, rowWidth(row));
+      height += rowHeight(row);
+    });
+    return { width: width, height: height };
+  }
+
+  function screenBoxes(settings, cluster, tickers, uuid, screenWidth, t) {
+    var tape = tapeWidth(settings, tickers.length);
+    var screenOffset = wrapOffset(scrollOffset(settings, t), tape) + screenGlobalOffset(cluster, uuid);
+
+    var boxes = [];
+    tickers.forEach(function (ticker) {
+      var box = tickerBox(settings, ticker);
+      if (!box) {
+        return;
+      }
+      tileOffsets(box.offset - screenOffset, box.width, tape, screenWidth).forEach(function (offset) {
+        boxes.push({ ticker: ticker, offset: offset, width: box.width });
+      });
+    });
+
+    if ((settings.SpacerWidth || 0) > 0) {
+      var spacerWidth = settings.SpacerWidth;
+      tileOffsets(tickersWidth(settings, tickers.length) - screenOffset, spacerWidth, tape, screenWidth).forEach(function (offset) {
+        boxes.push({ ticker: null, offset: offset, width: spacerWidth });
+      });
+    }
+
+    return boxes;
+  }
+
+  // ----- Drawing, see gui/frame. -----
+
+  function rgba(c) {
+    c = c || {};
+    return "rgba(" + (c.Red || 0) + "," + (c.Green || 0) + "," + (c.Blue || 0) + "," + ((c.Alpha || 0) / 255) + ")";
+  }
+
+  function setFont(font) {
+    ctx.font = font.size + "px \"" + font.face + "\"";
+  }
+
+  function textWidth(font, text) {
+    setFont(font);
+    return ctx.measureText(text).width;
+  }
+
+  function fillText(font, color, align, x, y, text) {
+    setFont(font);
+    ctx.fillStyle = color;
+    ctx.textAlign = align;
+    ctx.textBaseline = "middle";
+    ctx.fillText(text, x, y);
+  }
+
+  function fillRoundedRect(x, y, w, h, radius, color) {
+    radius = Math.max(0, Math.min(radius, w / 2, h / 2));
+    ctx.beginPath();
+    ctx.moveTo(x + radius, y);
+    ctx.arcTo(x + w, y, x + w, y + h, radius);
+    ctx.arcTo(x + w, y + h, x, y + h, radius);
+    ctx.arcTo(x, y + h, x, y, radius);
+    ctx.arcTo(x, y, x + w, y, radius);
+    ctx.closePath();
+    ctx.fillStyle = color;
+    ctx.fill();
+  }
+
+  function priceText(ticker) {
+    return (ticker.Price || 0).toFixed(2);
+  }
+
+  function companyNameText(ticker) {
+    var companyName = ticker.CompanyName || "";
+    if (companyName.length >= MaxCompanyNameCharacters) {
+      companyName = companyName.substring(0, MaxCompanyNameCharacters - 3) + "...";
+    }
+    return companyName;
+  }
+
+  function signed(value) {
+    return (value >= 0 ? "+" : "") + value.toFixed(2);
+  }
+
+  function changeText(ticker) {
+    var changePercentage = 0;
+    if (ticker.PreviousClosePrice) {
+      changePercentage = ((ticker.Price || 0) / ticker.PreviousClosePrice - 1) * 100;
+    }
+    var priceDifference = (ticker.Price || 0) - (ticker.PreviousClosePrice || 0);
+    return signed(priceDifference) + " (" + signed(changePercentage) + "%)";
+  }
+
+  function leftColumnWidth(ticker) {
+    return Math.max(textWidth(UpperRowFont, ticker.Ticker), textWidth(BottomRowFont, companyNameText(ticker)));
+  }
+
+  function tickerBg(settings, leftOffset, boxWidth, screenHeight) {
+    var topOffset = (screenHeight / 2) - (TickerBoxHeight / 2);
+    fillRoundedRect(leftOffset + (TickerBoxMargin / 2), topOffset, boxWidth - TickerBoxMargin, TickerBoxHeight, TickerBoxBorderRadius, rgba(settings.TickerBoxBGColor));
+  }
+
+  function drawTicker(settings, ticker, tickerOffset, boxWidth, screenHeight) {
+    tickerBg(settings, tickerOffset, boxWidth, screenHeight);
+
+    var offsetLeft = (tickerOffset + (TickerBoxMargin / 2)) + TickerBoxPadding;
+    var offsetTop = (screenHeight / 2) - (TickerBoxHeight / 2);
+    var offsetRight = ((tickerOffset + boxWidth) - TickerBoxMargin) - TickerBoxPadding;
+
+    var upperRowTopOffset = offsetTop + (TickerBoxHeight * 0.33);
+    var lowerRowTopOffset = offsetTop + (TickerBoxHeight * 0.66);
+
+    var graphLeft = offsetLeft + GraphOffset;
+    if (settings.DynamicTickerWidths) {
+      graphLeft = offsetLeft + leftColumnWidth(ticker) + GraphSpacing;
+    }
+
+    var fontColor = rgba(settings.FontColor);
+    fillText(UpperRowFont, fontColor, "left", offsetLeft, upperRowTopOffset, ticker.Ticker);
+    fillText(UpperRowFont, fontColor, "right", offsetRight, upperRowTopOffset, priceText(ticker));
+    fillText(BottomRowFont, fontColor, "left", offsetLeft, lowerRowTopOffset, companyNameText(ticker));
+
+    var directionalColor = (ticker.PriceChangePercentage || 0) < 0 ? settings.DownColor : settings.UpColor;
+    fillText(BottomRowFont, rgba(directionalColor), "right", offsetRight, lowerRowTopOffset, changeText(ticker));
+
+    drawGraph(ticker, graphLeft, (screenHeight / 2) - (GraphSize / 2), GraphSize, GraphSize, rgba(directionalColor));
+  }
+
+  function drawSpacer(settings, spacerOffset, screenHeight) {
+    if (!settings.SpacerText) {
+      return;
+    }
+
+    tickerBg(settings, spacerOffset, settings.SpacerWidth, screenHeight);
+    fillText(UpperRowFont, rgba(settings.FontColor), "center", spacerOffset + (settings.SpacerWidth / 2), screenHeight / 2, settings.SpacerText);
+  }
+
+  function drawGraph(ticker, x, y, w, h, color) {
+    var aggs = ticker.Aggs || [];
+    if (aggs.length < 2) {
+      return;
+    }
+
+    var dx = w / (aggs.length - 1);
+    var min = 0;
+    var max = 0;
+    var path = aggs.map(function (agg, i) {
+      var price = agg.Price || 0;
+      if (price > max) {
+        max = price;
+      }
+      if (price < min || min === 0) {
+        min = price;
+      }
+      return { x: x + i * dx, y: price };
+    });
+
+    var midRange = (min + max) / 2;
+    var absMax = 0;
+    path.forEach(function (point) {
+      point.y = (point.y - midRange) / midRange;
+      absMax = Math.max(absMax, Math.abs(point.y));
+    });
+
+    if (absMax > graphViewportPercentage) {
+      path.forEach(function (point) {
+        point.y = (point.y / absMax) * graphViewportPercentage;
+      });
+    }
+
+    var middleOfViewport = h / 2;
+    var baseMultiplier = middleOfViewport / graphViewportPercentage;
+    path.forEach(function (point) {
+      point.y = (y + h) - ((baseMultiplier * point.y) + middleOfViewport);
+    });
+
+    ctx.beginPath();
+    ctx.moveTo(path[0].x, path[0].y);
+    for (var i = 1; i < path.length; i++) {
+      ctx.lineTo(path[i].x, path[i].y);
+    }
+    ctx.strokeStyle = color;
+    ctx.lineWidth = 4;
+    ctx.lineJoin = "miter";
+    ctx.stroke();
+
+    var last = path[path.length - 1];
+    ctx.beginPath();
+    ctx.arc(last.x, last.y, 6, 0, 2 * Math.PI);
+    ctx.fillStyle = color;
+    ctx.fill();
+  }
+
+  // ----- Announcements, see gui/notifications. -----
+
+  var ease = {
+    inQuint: function (t) { return t * t * t * t * t; },
+    outQuint: function (t) { t -= 1; return t * t * t * t * t + 1; },
+    inElastic: function (t) { var p = 0.5; t -= 1; return -1 * (Math.pow(2, 10 * t) * Math.sin((t - p / 4) * (2 * Math.PI) / p)); },
+    outElastic: function (t) { var p = 0.5; return Math.pow(2, -10 * t) * Math.sin((t - p / 4) * (2 * Math.PI / p)) + 1; },
+    inBack: function (t) { var s = 1.70158; return t * t * ((s + 1) * t - s); },
+    outBack: function (t) { var s = 1.70158; t -= 1; return t * t * ((s + 1) * t + s) + 1; },
+    outBounce: function (t) {
+      if (t < 4 / 11) {
+        return (121 * t * t) / 16;
+      } else if (t < 8 / 11) {
+        return (363 / 40 * t * t) - (99 / 10 * t) + 17 / 5;
+      } else if (t < 9 / 10) {
+        return (4356 / 361 * t * t) - (35442 / 1805 * t) + 16061 / 1805;
+      }
+      return (54 / 5 * t * t) - (513 / 25 * t) + 268 / 25;
+    }
+  };
+
+  function animations(announcement) {
+    switch (announcement.Animation || 0) {
+      case AnnouncementAnimationBounce:
+        return { in: ease.outBounce, out: ease.inElastic };
+      case AnnouncementAnimationEase:
+        return { in: ease.outQuint, out: ease.inQuint };
+      case AnnouncementAnimationBack:
+        return { in: ease.outBack, out: ease.inBack };
+    }
+    return { in: ease.outElastic, out: ease.inElastic };
+  }
+
+  function drawAnnouncements(settings, cluster, t) {
+    var animationDuration = settings.AnimationDurationMS || 0;
+
+    // Forget about announcements which have finished.
+    state.announcements = state.announcements.filter(function (announcement) {
+      return t <= num(announcement.ShowAtTimestampMS) + num(announcement.LifespanMS) + animationDuration;
+    });
+
+    state.announcements.forEach(function (announcement) {
+      var showAt = num(announcement.ShowAtTimestampMS);
+      var lifespan = num(announcement.LifespanMS);
+      if (t < showAt) {
+        return;
+      }
+
+      var size = canvasSize(cluster);
+      var position = screenPosition(cluster, viewUUID(cluster));
+      var anim = animations(announcement);
+
+      var textTopStart = -size.height;
+      var textTopEnd = (size.height / 2) - 10;
+      var textTop = textTopEnd;
+      var bgBottomStart = 0;
+      var bgBottomEnd = size.height;
+      var bgBottom = bgBottomEnd;
+      var completed;
+
+      if (t - showAt < animationDuration) {
+        completed = anim.in((t - showAt) / animationDuration);
+        bgBottom = bgBottomStart - ((bgBottomStart - bgBottomEnd) * completed);
+        textTop = textTopStart - ((textTopStart - textTopEnd) * completed);
+      } else if (t > showAt + lifespan) {
+        completed = anim.out((t - (showAt + lifespan)) / animationDuration);
+        bgBottom = bgBottomEnd - ((bgBottomEnd - bgBottomStart) * completed);
+        textTop = textTopEnd - ((textTopEnd - textTopStart) * completed);
+      }
+      var bgTop = bgBottom - size.height;
+
+      var bgColor = "rgba(122,122,255,0.87)";
+      if (announcement.AnnouncementType === AnnouncementTypeDanger) {
+        bgColor = "rgba(255,122,122,0.87)";
+      } else if (announcement.AnnouncementType === AnnouncementTypeSuccess) {
+        bgColor = "rgba(122,255,122,0.87)";
+      }
+
+      ctx.save();
+      ctx.translate(-position.x, -position.y);
+      // Matches the GUI, which fills from the top of the background to the wall's height.
+      ctx.fillStyle = bgColor;
+      ctx.fillRect(0, bgTop, size.width, bgBottom);
+      fillText({ face: "sans-bold", size: 96 }, "#fff", "center", size.width / 2, textTop, announcement.Message || "");
+      ctx.restore();
+    });
+  }
+
+  function drawSystemPanel(message, width, height) {
+    var panelHeight = 200;
+    var padding = 20;
+    fillRoundedRect(padding, (height / 2) - (panelHeight / 2), width - (padding * 2), panelHeight, 5, "rgba(255,0,0,0.87)");
+    fillText({ face: "sans-bold", size: 32 }, "#fff", "center", width / 2, height / 2, message);
+  }
+
+  // ----- Render loop -----
+
+  function resizeCanvas() {
+    var ratio = window.devicePixelRatio || 1;
+    state.screen.Width = window.innerWidth;
+    state.screen.Height = window.innerHeight;
+    canvas.width = Math.round(state.screen.Width * ratio);
+    canvas.height = Math.round(state.screen.Height * ratio);
+    canvas.style.width = state.screen.Width + "px";
+    canvas.style.height = state.screen.Height + "px";
+  }
+
+  function renderFrame() {
+    var ratio = window.devicePixelRatio || 1;
+    var width = state.screen.Width;
+    var height = state.screen.Height;
+
+    ctx.setTransform(ratio, 0, 0, ratio, 0, 0);
+    ctx.clearRect(0, 0, width, height);
+
+    var cluster = state.cluster;
+    if (cluster && cluster.Settings) {
+      var settings = previewSettings(cluster.Settings);
+      var t = now();
+
+      ctx.fillStyle = rgba(settings.BGColor);
+      ctx.fillRect(0, 0, width, height);
+
+      screenBoxes(settings, cluster, state.tickers, viewUUID(cluster), width, t).forEach(function (box) {
+        if (box.ticker === null) {
+          drawSpacer(settings, box.offset, height);
+          return;
+        }
+        drawTicker(settings, box.ticker, box.offset, box.width, height);
+      });
+
+      drawAnnouncements(settings, cluster, t);
+    }
+
+    if (!state.connected) {
+      drawSystemPanel(cluster ? "Reconnecting to Leader.." : "Connecting to Leader..", width, height);
+    }
+
+    window.requestAnimationFrame(renderFrame);
+  }
+
+  // previewSettings draws the admin dashboards draft settings over the clusters settings.
+  function previewSettings(settings) {
+    if (!state.preview) {
+      return settings;
+    }
+
+    var merged = {};
+    Object.keys(settings).forEach(function (key) {
+      merged[key] = settings[key];
+    });
+    Object.keys(state.preview).forEach(function (key) {
+      merged[key] = state.preview[key];
+    });
+    return merged;
+  }
+
+  // The admin dashboard embeds this page, and posts it's draft settings to it.
+  window.addEventListener("message", function (event) {
+    if (event.origin !== window.location.origin || !event.data || event.data.type !== "preview") {
+      return;
+    }
+    state.preview = event.data.settings || null;
+  });
+
+  var resizeTimer = null;
+  window.addEventListener("resize", function () {
+    resizeCanvas();
+
+    // Wait until the resize is finished before telling the leader.
+    clearTimeout(resizeTimer);
+    resizeTimer = setTimeout(sendScreenUpdate, 250);
+  });
+
+  // Wait for the fonts, so text is measured with the right font from the first frame.
+  var fontsReady = document.fonts ? Promise.all([
+    document.fonts.load(UpperRowFont.size + "px \"sans-bold\""),
+    document.fonts.load(BottomRowFont.size + "px \"sans-light\""),
+    document.fonts.load("32px \"sans\"")
+  ]) : Promise.resolve();
+
+  fontsReady.then(start, start);
+
+  function start() {
+    connect();
+    syncClock();
+    setInterval(syncClock, clockSyncInterval);
+    window.requestAnimationFrame(renderFrame);
+  }
+})();
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/leader"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// webSocketWriteTimeout is how long sending an update to a WebSocket screen can take before it's
+// dropped from the cluster.
+const webSocketWriteTimeout = 10 * time.Second
+
+// upgrader upgrades HTTP requests to WebSockets. The origin has already been checked by
+// checkOrigin, which knows the origins allowed by the config.
+// nolint:gochecknoglobals // upgraders are safe to share.
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// checkOrigin rejects WebSockets opened by pages of other sites, unless the config allows them.
+// Otherwise any page the leader's users visit could join a wall with their credentials.
+func checkOrigin(authCfg *auth.Config) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if origin := c.GetHeader("Origin"); !authCfg.AllowedOrigin(origin, c.Request.Host) {
+			writeError(c, status.Errorf(codes.PermissionDenied, "WebSockets from %s are not allowed", origin))
+			return
+		}
+		c.Next()
+	}
+}
+
+// joinClusterWebSocket joins the cluster as a screen over a WebSocket, for screens which can't use
+// gRPC ( eg: browsers ). The screen is described by the query, eg:
+// /v1/ws?wall=lobby&index=2&width=1920&height=1080
+//
+// The screen is sent the same updates JoinCluster streams, starting with a snapshot of the wall,
+// each as a protojson text message. The screen can send protojson Screen
+// messages to update itself, the same as UpdateScreen.
+func joinClusterWebSocket(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		screen, err := screenFromQuery(c.Request.URL.Query())
+		if err != nil {
+			writeError(c, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		// Check the wall exists first, so the screen gets an error response rather than a closed socket.
+		if _, err := leaderObj.CurrentScreenCluster(screen.Wall); err != nil {
+			writeError(c, err)
+			return
+		}
+
+		// Upgrade writes the error response itself.
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			logrus.WithError(err).Debug("Unable to upgrade to a WebSocket.")
+			return
+		}
+		defer conn.Close()
+
+		ctx, cancel := context.WithCancel(c.Request.Context())
+		defer cancel()
+
+		send := func(update *models.Update) error {
+			data, err := protojson.Marshal(update)
+			if err != nil {
+				return err
+			}
+
+			if err := conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
+				return err
+			}
+			return conn.WriteMessage(websocket.TextMessage, data)
+		}
+
+		// The screen has disconnected once we can't read from it anymore.
+		go func() {
+			defer cancel()
+			readScreenUpdates(leader.OwnScreenContext(ctx, screen.UUID), conn, leaderObj, screen)
+		}()
+
+		if err := leaderObj.ServeScreen(ctx, screen, send); err != nil {
+			logrus.WithError(err).Debug("WebSocket screen disconnected.")
+		}
+	}
+}
+
+// readScreenUpdates reads screen updates sent by a WebSocket screen, until it's closed.
+func readScreenUpdates(ctx context.Context, conn *websocket.Conn, leaderObj *leader.Leader, screen *models.Screen) {
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		update := &models.Screen{}
+		if err := protojson.Unmarshal(data, update); err != nil {
+			logrus.WithError(err).Debug("Invalid screen update from WebSocket screen.")
+			continue
+		}
+
+		// Screens can only update themselves.
+		update.UUID = screen.UUID
+		update.Wall = screen.Wall
+		update.Observer = screen.Observer
+
+		if _, err := leaderObj.UpdateScreen(ctx, update); err != nil {
+			logrus.WithError(err).Debug("Unable to update WebSocket screen.")
+		}
+	}
+}
+
+// screenFromQuery creates the screen described by a WebSocket request's query. A UUID is created
+// when one isn't given.
+func screenFromQuery(query url.Values) (*models.Screen, error) {
+	screen := &models.Screen{
+		UUID:     query.Get("uuid"),
+		Wall:     models.WallName(query.Get("wall")),
+		Observer: query.Get("observer") == "true",
+
+		// Updates are sent as v1 protojson, the browser screen is served by the leader so it always
+		// speaks the same protocol.
+		ProtocolVersion: models.ProtocolV1,
+	}
+	if screen.UUID == "" {
+		screen.UUID = uuid.NewString()
+	}
+
+	fields := []struct {
+		name  string
+		value *int32
+	}{
+		{"width", &screen.Width},
+		{"height", &screen.Height},
+		{"index", &screen.Index},
+		{"row", &screen.Row},
+		{"bezelLeft", &screen.BezelLeft},
+		{"bezelRight", &screen.BezelRight},
+		{"gap", &screen.Gap},
+	}
+	for _, field := range fields {
+		value := query.Get(field.name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", field.name, err)
+		}
+		*field.value = int32(parsed)
+	}
+
+	return screen, nil
+}
+
+// syncClock responds with the leaders clock, so screens which can't use gRPC can keep their clock
+// in sync. The request and response are the protojson SyncClock messages.
+func syncClock(leaderObj *leader.Leader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		req := &models.ClockSyncRequest{}
+		if _, err := readProto(c, req); err != nil {
+			writeError(c, err)
+			return
+		}
+
+		res, err := leaderObj.SyncClock(c.Request.Context(), req)
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		writeProto(c, res)
+	}
+}
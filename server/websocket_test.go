@@ -0,0 +1,86 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/polygon-io/go-app-ticker-wall/auth"
+	"github.com/polygon-io/go-app-ticker-wall/leader"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+func TestScreenFromQuery(t *testing.T) {
+	screen, err := screenFromQuery(url.Values{"uuid": {"a"}, "wall": {" Lobby "}, "width": {"1920"}, "observer": {"true"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if screen.UUID != "a" || screen.Wall != "lobby" || screen.Width != 1920 || !screen.Observer {
+		t.Errorf("screenFromQuery() = %v", screen)
+	}
+
+	// Each screen without a UUID is given it's own.
+	first, _ := screenFromQuery(url.Values{})
+	second, _ := screenFromQuery(url.Values{})
+	if first.UUID == "" || first.UUID == second.UUID {
+		t.Errorf("UUIDs = %q and %q, want a new one for each screen", first.UUID, second.UUID)
+	}
+
+	if _, err := screenFromQuery(url.Values{"width": {"wide"}}); err == nil {
+		t.Error("screenFromQuery() with an invalid width should fail")
+	}
+}
+
+func TestJoinClusterWebSocket(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	leaderObj, err := leader.New(&leader.Config{
+		TickerList:   "AAPL",
+		Source:       leader.SourceSim,
+		Presentation: &models.PresentationSettings{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := gin.New()
+	registerAPIRoutes(r, &auth.Config{}, leaderObj)
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/v1/ws?uuid=a"
+	first, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	if _, _, err := first.ReadMessage(); err != nil {
+		t.Fatalf("didn't get the snapshot: %v", err)
+	}
+
+	// A second screen with the same UUID isn't added to the wall.
+	second, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	if _, _, err := second.ReadMessage(); err == nil {
+		t.Error("a screen with the UUID of a connected screen joined the wall")
+	}
+
+	// Pages of other sites can't open WebSockets.
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"Origin": {"https://evil.example.com"}})
+	if err == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("WebSocket from another site = %v, want %d", err, http.StatusForbidden)
+	}
+
+	// Missing walls are an error, rather than a new wall.
+	_, resp, err = websocket.DefaultDialer.Dial(wsURL+"&wall=lobyb", nil)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("WebSocket to a missing wall = %v, want %d", err, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,287 @@
+// Package sim is a simulated market data source. It generates plausible company names, previous
+// closes, intraday aggregates and a stream of price updates using a seeded random walk, so the
+// ticker wall can run without access to a real market data feed ( demos, development, CI ).
+package sim
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+	"github.com/sirupsen/logrus"
+)
+
+// bufferedChannelSize defines how many items we buffer internally before we start blocking.
+const bufferedChannelSize = 10_000
+
+const (
+	// perTickInterval is how often we generate a price update when per tick updates are enabled.
+	perTickInterval = 100 * time.Millisecond
+	// perSecondInterval is how often we generate price updates otherwise, which mimics second aggregates.
+	perSecondInterval = time.Second
+
+	// dailyVolatility is the standard deviation of a tickers daily return.
+	dailyVolatility = 0.02
+	// meanReversion pulls prices back towards the previous close so a long running demo stays plausible.
+	meanReversion = 0.002
+)
+
+// Client is a simulated market data source. The same seed and ticker always generate the same
+// company details and starting prices.
+type Client struct {
+	sync.Mutex
+	priceUpdates   chan *models.PriceUpdate
+	perTickUpdates bool
+	seed           int64
+
+	// rand drives the live price stream, it must only be used while holding the lock.
+	rand    *rand.Rand
+	tickers map[string]*tickerState
+
+	// subscriptions are the tickers we are streaming price updates for, in subscription order.
+	subscriptions []string
+}
+
+// tickerState is the simulated state of a single ticker.
+type tickerState struct {
+	companyName       string
+	outstandingShares int64
+	previousClose     float64
+	price             float64
+}
+
+// NewClient creates a new simulated data source. A seed of 0 uses a random seed.
+func NewClient(seed int64, perTickUpdates bool) *Client {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	logrus.WithField("seed", seed).Info("Using simulated market data.")
+
+	return &Client{
+		priceUpdates:   make(chan *models.PriceUpdate, bufferedChannelSize),
+		perTickUpdates: perTickUpdates,
+		seed:           seed,
+		// nolint:gosec // This is a simulation, it does not need to be cryptographically secure.
+		rand:    rand.New(rand.NewSource(seed)),
+		tickers: make(map[string]*tickerState),
+	}
+}
+
+// PriceUpdates is the channel real-time price updates are published on.
+func (c *Client) PriceUpdates() <-chan *models.PriceUpdate {
+	return c.priceUpdates
+}
+
+// LoadTickerData gets the simulated details of a ticker.
+func (c *Client) LoadTickerData(ctx context.Context, tickerSymbol string) (*models.Ticker, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	state := c.tickerState(tickerSymbol)
+
+	return &models.Ticker{
+		Ticker:             tickerSymbol,
+		CompanyName:        state.companyName,
+		OutstandingShares:  state.outstandingShares,
+		PreviousClosePrice: state.previousClose,
+		Price:              state.price,
+	}, nil
+}
+
+// GetTickerTodayAggs generates the aggregates for a ticker on the given day. The aggregates walk
+// from the previous close to the current price, so charts line up with the live prices.
+func (c *Client) GetTickerTodayAggs(ctx context.Context, t time.Time, ticker string, rangeSize int) ([]*models.Agg, error) {
+	// Without time zone data ( e.g. minimal containers ) the window is in UTC instead.
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		loc = time.UTC
+	}
+
+	// Same trading window as the Polygon.io client.
+	openTime := time.Date(t.Year(), t.Month(), t.Day(), 9, 0, 0, 0, loc)
+	closeTime := time.Date(t.Year(), t.Month(), t.Day(), 16, 30, 0, 0, loc)
+	if now := time.Now(); now.Before(closeTime) {
+		closeTime = now
+	}
+
+	if rangeSize < 1 {
+		rangeSize = 1
+	}
+	barSize := time.Duration(rangeSize) * time.Minute
+	bars := int(closeTime.Sub(openTime) / barSize)
+	if bars < 1 {
+		return nil, nil
+	}
+
+	c.Lock()
+	state := c.tickerState(ticker)
+	previousClose, price := state.previousClose, state.price
+	c.Unlock()
+
+	// Each day of each ticker has its own deterministic walk.
+	rnd := newTickerRand(c.seed, ticker, openTime.Unix())
+	stepVolatility := dailyVolatility / math.Sqrt(float64(bars))
+
+	walk := make([]float64, bars)
+	current := 0.0
+	for i := range walk {
+		current += rnd.NormFloat64() * stepVolatility
+		walk[i] = current
+	}
+
+	// Pin the walk to the current price ( brownian bridge ), so the last bar matches the live price.
+	drift := math.Log(price/previousClose) - walk[bars-1]
+
+	results := make([]*models.Agg, 0, bars)
+	for i, step := range walk {
+		progress := float64(i+1) / float64(bars)
+		results = append(results, &models.Agg{
+			Price:     roundPrice(previousClose * math.Exp(step+(drift*progress))),
+			Volume:    int32(1_000 + rnd.Intn(100_000)),
+			Timestamp: openTime.Add(time.Duration(i) * barSize).UnixMilli(),
+		})
+	}
+
+	return results, nil
+}
+
+// ListenForTickerUpdates generates price updates for the given tickers, and any tickers
+// subscribed to later, until the context is done.
+func (c *Client) ListenForTickerUpdates(ctx context.Context, tickers []string) error {
+	if err := c.Subscribe(tickers...); err != nil {
+		return err
+	}
+
+	interval := perSecondInterval
+	if c.perTickUpdates {
+		interval = perTickInterval
+	}
+
+	timer := time.NewTicker(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			for _, update := range c.step(interval) {
+				select {
+				case <-ctx.Done():
+					return nil
+				case c.priceUpdates <- update:
+				}
+			}
+		}
+	}
+}
+
+// Subscribe starts generating price updates for the given tickers.
+func (c *Client) Subscribe(tickers ...string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, ticker := range tickers {
+		if !c.isSubscribed(ticker) {
+			c.subscriptions = append(c.subscriptions, ticker)
+		}
+	}
+
+	return nil
+}
+
+// Unsubscribe stops generating price updates for the given tickers.
+func (c *Client) Unsubscribe(tickers ...string) error {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, ticker := range tickers {
+		for i, subscription := range c.subscriptions {
+			if subscription == ticker {
+				c.subscriptions = append(c.subscriptions[:i], c.subscriptions[i+1:]...)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// isSubscribed checks if we are generating price updates for a ticker. Must be called while holding the lock.
+func (c *Client) isSubscribed(ticker string) bool {
+	for _, subscription := range c.subscriptions {
+		if subscription == ticker {
+			return true
+		}
+	}
+	return false
+}
+
+// step moves the prices of the subscribed tickers forward by the given interval. When per tick
+// updates are enabled only a random subset of tickers trade each step, like a real market.
+func (c *Client) step(interval time.Duration) []*models.PriceUpdate {
+	c.Lock()
+	defer c.Unlock()
+
+	// Scale the daily volatility down to the size of our step ( 6.5 hour trading day ).
+	stepVolatility := dailyVolatility * math.Sqrt(float64(interval)/float64(6*time.Hour+30*time.Minute))
+
+	updates := make([]*models.PriceUpdate, 0, len(c.subscriptions))
+	for _, ticker := range c.subscriptions {
+		if c.perTickUpdates && c.rand.Float64() > 0.3 {
+			continue
+		}
+
+		state := c.tickerState(ticker)
+		reversion := meanReversion * math.Log(state.previousClose/state.price)
+		state.price = roundPrice(state.price * math.Exp(reversion+(c.rand.NormFloat64()*stepVolatility)))
+
+		updates = append(updates, &models.PriceUpdate{
+			Ticker: ticker,
+			Price:  state.price,
+		})
+	}
+
+	return updates
+}
+
+// tickerState gets or creates the simulated state for a ticker. Must be called while holding the lock.
+func (c *Client) tickerState(ticker string) *tickerState {
+	if state, ok := c.tickers[ticker]; ok {
+		return state
+	}
+
+	rnd := newTickerRand(c.seed, ticker, 0)
+
+	// Log-uniform previous close between $5 and $500.
+	previousClose := roundPrice(math.Exp(math.Log(5) + (rnd.Float64() * (math.Log(500) - math.Log(5)))))
+
+	state := &tickerState{
+		companyName:       companyName(rnd),
+		outstandingShares: int64(50_000_000 + rnd.Intn(5_000_000_000)),
+		previousClose:     previousClose,
+		price:             roundPrice(previousClose * math.Exp(rnd.NormFloat64()*dailyVolatility)),
+	}
+	c.tickers[ticker] = state
+
+	return state
+}
+
+// newTickerRand creates a random source which is unique to the seed, ticker and salt.
+func newTickerRand(seed int64, ticker string, salt int64) *rand.Rand {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(ticker))
+
+	// nolint:gosec // This is a simulation, it does not need to be cryptographically secure.
+	return rand.New(rand.NewSource(seed ^ int64(h.Sum64()) ^ salt))
+}
+
+// roundPrice rounds a price to the nearest cent.
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
@@ -0,0 +1,111 @@
+package sim
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSameSeedSamePrices(t *testing.T) {
+	ctx := context.Background()
+	a := NewClient(42, true)
+	b := NewClient(42, true)
+	for _, c := range []*Client{a, b} {
+		if err := c.Subscribe("AAPL", "MSFT", "TSLA"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, symbol := range []string{"AAPL", "MSFT", "TSLA"} {
+		tickerA, _ := a.LoadTickerData(ctx, symbol)
+		tickerB, _ := b.LoadTickerData(ctx, symbol)
+		if !proto.Equal(tickerA, tickerB) {
+			t.Errorf("%s details = %v and %v, want them the same", symbol, tickerA, tickerB)
+		}
+	}
+
+	for i := 0; i < 100; i++ {
+		updatesA := a.step(perTickInterval)
+		updatesB := b.step(perTickInterval)
+		if len(updatesA) != len(updatesB) {
+			t.Fatalf("step %d has %d and %d updates, want them the same", i, len(updatesA), len(updatesB))
+		}
+		for j := range updatesA {
+			if !proto.Equal(updatesA[j], updatesB[j]) {
+				t.Fatalf("step %d update = %v and %v, want them the same", i, updatesA[j], updatesB[j])
+			}
+		}
+	}
+
+	// A different seed takes a different walk.
+	c := NewClient(43, true)
+	tickerA, _ := a.LoadTickerData(ctx, "AAPL")
+	tickerC, _ := c.LoadTickerData(ctx, "AAPL")
+	if tickerA.PreviousClosePrice == tickerC.PreviousClosePrice {
+		t.Errorf("seeds 42 and 43 both have a previous close of %v", tickerA.PreviousClosePrice)
+	}
+}
+
+func TestPricesStayPositive(t *testing.T) {
+	c := NewClient(7, false)
+	if err := c.Subscribe("AAPL", "MSFT", "TSLA", "AMD", "GME"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A couple of years worth of trading days.
+	for i := 0; i < 500; i++ {
+		for _, update := range c.step(6*time.Hour + 30*time.Minute) {
+			if update.Price <= 0 {
+				t.Fatalf("step %d: %s price = %v, want it positive", i, update.Ticker, update.Price)
+			}
+		}
+	}
+}
+
+func TestTodayAggs(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient(42, false)
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		loc = time.UTC
+	}
+	day := time.Date(2022, 4, 14, 12, 0, 0, 0, loc)
+	openTime := time.Date(2022, 4, 14, 9, 0, 0, 0, loc)
+	closeTime := time.Date(2022, 4, 14, 16, 30, 0, 0, loc)
+
+	aggs, err := c.GetTickerTodayAggs(ctx, day, "AAPL", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aggs) != 45 {
+		t.Fatalf("got %d aggs, want 45 ten minute bars", len(aggs))
+	}
+
+	for i, agg := range aggs {
+		at := time.UnixMilli(agg.Timestamp)
+		if at.Before(openTime) || !at.Before(closeTime) {
+			t.Errorf("agg %d at %v, want it between %v and %v", i, at, openTime, closeTime)
+		}
+		if i > 0 && agg.Timestamp <= aggs[i-1].Timestamp {
+			t.Errorf("agg %d at %v is not after the agg before it", i, at)
+		}
+		if agg.Price <= 0 {
+			t.Errorf("agg %d price = %v, want it positive", i, agg.Price)
+		}
+	}
+
+	// The chart ends at the live price.
+	ticker, _ := c.LoadTickerData(ctx, "AAPL")
+	if last := aggs[len(aggs)-1].Price; last != ticker.Price {
+		t.Errorf("last agg price = %v, want the current price %v", last, ticker.Price)
+	}
+
+	// The market hasn't opened yet on days in the future.
+	aggs, err = c.GetTickerTodayAggs(ctx, time.Now().AddDate(0, 0, 2), "AAPL", 10)
+	if err != nil || len(aggs) != 0 {
+		t.Errorf("future aggs = %v, %v, want none", aggs, err)
+	}
+}
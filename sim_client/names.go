@@ -0,0 +1,33 @@
+package sim
+
+import (
+	"math/rand"
+	"strings"
+)
+
+// nolint:gochecknoglobals // Static word lists used to generate company names.
+var (
+	namePrefixes = []string{
+		"American", "Apex", "Atlas", "Blue", "Cascade", "Crescent", "Evergreen", "First", "Global",
+		"Golden", "Granite", "Harbor", "Horizon", "Iron", "Liberty", "Meridian", "National", "Northern",
+		"Pacific", "Pinnacle", "Quantum", "Redwood", "Silver", "Summit", "United", "Vertex",
+	}
+	nameCores = []string{
+		"Analytics", "Biosciences", "Brands", "Capital", "Communications", "Dynamics", "Energy",
+		"Financial", "Foods", "Health", "Industries", "Logistics", "Materials", "Media", "Micro Devices",
+		"Motors", "Networks", "Pharmaceuticals", "Resources", "Semiconductor", "Software", "Systems",
+		"Technologies", "Therapeutics",
+	}
+	nameSuffixes = []string{
+		"Inc.", "Corp", "Holdings", "Group", "Co.", "Ltd.", "Inc. Class A Common Stock",
+	}
+)
+
+// companyName generates a plausible company name.
+func companyName(rnd *rand.Rand) string {
+	return strings.Join([]string{
+		namePrefixes[rnd.Intn(len(namePrefixes))],
+		nameCores[rnd.Intn(len(nameCores))],
+		nameSuffixes[rnd.Intn(len(nameSuffixes))],
+	}, " ")
+}
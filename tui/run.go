@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/polygon-io/go-app-ticker-wall/client"
+	"github.com/sirupsen/logrus"
+
+	tombv2 "gopkg.in/tomb.v2"
+)
+
+type Config struct {
+	// FPS is how many times a second the terminal is redrawn.
+	FPS          int
+	ClientConfig client.Config
+}
+
+func Run(cfg *Config) error {
+	if cfg.FPS <= 0 {
+		return errors.New("fps must be more than 0")
+	}
+
+	// Stop on interrupt, so the terminal is put back how we found it.
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Log messages would draw over the tape, so only show them if something is really wrong.
+	if logrus.GetLevel() < logrus.DebugLevel {
+		logrus.SetLevel(logrus.FatalLevel)
+	}
+
+	// Join as an observer so we aren't part of the screen layout.
+	cfg.ClientConfig.Observer = true
+	tickerWallClient, err := client.New(cfg.ClientConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create client: %w", err)
+	}
+
+	tomb, ctx := tombv2.WithContext(ctx)
+
+	tomb.Go(func() error {
+		return tickerWallClient.Run(ctx)
+	})
+
+	tomb.Go(func() error {
+		return NewTUI(tickerWallClient, os.Stdout).Run(ctx, cfg.FPS)
+	})
+
+	// Being interrupted is a normal exit.
+	if err := tomb.Wait(); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/polygon-io/go-app-ticker-wall/layout"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// ANSI escape codes used to style the tape.
+const (
+	styleReset = "\x1b[0m"
+	styleBold  = "\x1b[1m"
+	styleDim   = "\x1b[2m"
+)
+
+// separator is drawn at the end of each box on the tape.
+const separator = " │"
+
+// cell is a single character on the terminal, and the ANSI style it's drawn with.
+type cell struct {
+	r     rune
+	style string
+}
+
+// tapeBox is where a box is on the wall's tape, in pixels, and on the terminal's tape, in characters.
+type tapeBox struct {
+	offset, width float64
+	start, length int
+}
+
+// tape is one repetition of the ticker tape, as characters. The wall's tape is measured in pixels
+// and the terminal's in characters, so boxes are matched up one to one and positions are mapped
+// between them by how far through the box they are.
+type tape struct {
+	cells []cell
+	boxes []tapeBox
+}
+
+// newTape lays out the tickers as characters, in the same order they are on the wall.
+func newTape(settings *models.PresentationSettings, tickers []*models.Ticker) *tape {
+	type positioned struct {
+		offset, width float64
+		cells         []cell
+	}
+
+	var boxes []positioned
+	for _, ticker := range tickers {
+		offset, width, ok := settings.TickerBox(ticker)
+		if !ok {
+			continue
+		}
+		boxes = append(boxes, positioned{offset: offset, width: width, cells: tickerCells(settings, ticker)})
+	}
+	sort.Slice(boxes, func(i, j int) bool { return boxes[i].offset < boxes[j].offset })
+
+	if settings.SpacerWidth > 0 {
+		boxes = append(boxes, positioned{
+			offset: settings.TickersWidth(len(tickers)),
+			width:  float64(settings.SpacerWidth),
+			cells:  spacerCells(settings.SpacerText),
+		})
+	}
+
+	res := &tape{}
+	for _, box := range boxes {
+		res.boxes = append(res.boxes, tapeBox{
+			offset: box.offset,
+			width:  box.width,
+			start:  len(res.cells),
+			length: len(box.cells),
+		})
+		res.cells = append(res.cells, box.cells...)
+	}
+	return res
+}
+
+// tickerCells is the ticker, as it's drawn on the terminal. Eg: " AAPL 165.29 +5.05 (+3.15%) │".
+func tickerCells(settings *models.PresentationSettings, ticker *models.Ticker) []cell {
+	directionalColor := settings.UpColor
+	if ticker.PriceChangePercentage < 0 {
+		directionalColor = settings.DownColor
+	}
+
+	var res []cell
+	res = appendCells(res, " ", "")
+	res = appendCells(res, ticker.Ticker, styleBold)
+	res = appendCells(res, " "+layout.PriceText(ticker)+" ", "")
+	res = appendCells(res, layout.ChangeText(ticker), foreground(directionalColor))
+	return appendCells(res, separator, styleDim)
+}
+
+// spacerCells is the spacer card between repetitions of the tape.
+func spacerCells(text string) []cell {
+	var res []cell
+	if text != "" {
+		res = appendCells(res, "  ", "")
+		res = appendCells(res, text, styleBold)
+	}
+	res = appendCells(res, "  ", "")
+	return appendCells(res, separator, styleDim)
+}
+
+func appendCells(cells []cell, text, style string) []cell {
+	for _, r := range text {
+		cells = append(cells, cell{r: r, style: style})
+	}
+	return cells
+}
+
+// column maps a position on the wall's tape, in pixels, to a column on the terminal's tape.
+func (t *tape) column(position float64) int {
+	for _, box := range t.boxes {
+		if position < box.offset || position >= box.offset+box.width {
+			continue
+		}
+		return box.start + int(math.Floor((position-box.offset)/box.width*float64(box.length)))
+	}
+
+	// Tickers which were just added may not be in the layout yet.
+	return 0
+}
+
+// window gets the characters visible on a terminal of the given width, when the left edge of the
+// terminal is at the given position on the wall's tape. The tape repeats to fill the terminal.
+func (t *tape) window(position float64, columns int) []cell {
+	if len(t.cells) == 0 || columns <= 0 {
+		return nil
+	}
+
+	res := make([]cell, columns)
+	start := t.column(position)
+	for i := range res {
+		res[i] = t.cells[(start+i)%len(t.cells)]
+	}
+	return res
+}
+
+// renderCells writes out the characters, changing styles only when needed.
+func renderCells(cells []cell) string {
+	var b strings.Builder
+	style := ""
+	for _, c := range cells {
+		if c.style != style {
+			b.WriteString(styleReset)
+			b.WriteString(c.style)
+			style = c.style
+		}
+		b.WriteRune(c.r)
+	}
+	if style != "" {
+		b.WriteString(styleReset)
+	}
+	return b.String()
+}
+
+// foreground is the ANSI code to use the color as the text color.
+func foreground(c *models.RGBA) string {
+	if c == nil {
+		return ""
+	}
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm", c.Red, c.Green, c.Blue)
+}
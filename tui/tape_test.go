@@ -0,0 +1,148 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+func testSettings() *models.PresentationSettings {
+	return &models.PresentationSettings{
+		TickerBoxWidth: 1000,
+		UpColor:        &models.RGBA{Red: 51, Green: 255, Blue: 51, Alpha: 255},
+		DownColor:      &models.RGBA{Red: 255, Green: 51, Blue: 51, Alpha: 255},
+	}
+}
+
+func testTickers() []*models.Ticker {
+	return []*models.Ticker{
+		{Ticker: "AAPL", Index: 0, Price: 165.29, PreviousClosePrice: 160.24, PriceChangePercentage: 3.15},
+		{Ticker: "F", Index: 1, Price: 16.5, PreviousClosePrice: 16.5},
+	}
+}
+
+// text is the characters, without their styles.
+func text(cells []cell) string {
+	var b strings.Builder
+	for _, c := range cells {
+		b.WriteRune(c.r)
+	}
+	return b.String()
+}
+
+const (
+	aaplText = " AAPL 165.29 +5.05 (+3.15%) │"
+	fText    = " F 16.50 +0.00 (+0.00%) │"
+)
+
+func TestTapeWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings func() *models.PresentationSettings
+		position float64
+		columns  int
+		want     string
+	}{
+		{
+			name:     "start of the tape",
+			settings: testSettings,
+			position: 0,
+			columns:  len([]rune(aaplText)),
+			want:     aaplText,
+		},
+		{
+			name:     "start of the second box",
+			settings: testSettings,
+			position: 1000,
+			columns:  len([]rune(fText)),
+			want:     fText,
+		},
+		{
+			name:     "part way through a box",
+			settings: testSettings,
+			position: 1500,
+			columns:  5,
+			want:     string([]rune(fText)[12:17]),
+		},
+		{
+			name:     "repeats to fill the terminal",
+			settings: testSettings,
+			position: 0,
+			columns:  2*len([]rune(aaplText+fText)) + 5,
+			want:     aaplText + fText + aaplText + fText + " AAPL",
+		},
+		{
+			name: "spacer after the tickers",
+			settings: func() *models.PresentationSettings {
+				settings := testSettings()
+				settings.SpacerWidth = 500
+				settings.SpacerText = "Polygon.io"
+				return settings
+			},
+			position: 2000,
+			columns:  len([]rune("  Polygon.io   │")) + 5,
+			want:     "  Polygon.io   │ AAPL",
+		},
+		{
+			name: "dynamic widths follow the layout",
+			settings: func() *models.PresentationSettings {
+				settings := testSettings()
+				settings.TickerLayout = &models.TickerLayout{
+					Boxes: []*models.TickerBox{
+						{Ticker: "F", Offset: 0, Width: 300},
+						{Ticker: "AAPL", Offset: 300, Width: 900},
+					},
+					TapeWidth: 1200,
+				}
+				return settings
+			},
+			position: 300,
+			columns:  len([]rune(aaplText + fText)),
+			want:     aaplText + fText,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := text(newTape(tt.settings(), testTickers()).window(tt.position, tt.columns)); got != tt.want {
+				t.Errorf("window() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTapeWindowEmpty(t *testing.T) {
+	if got := newTape(testSettings(), nil).window(0, 80); len(got) != 0 {
+		t.Errorf("window() = %q, want nothing", text(got))
+	}
+}
+
+func TestTickerCellsColors(t *testing.T) {
+	settings := testSettings()
+	up, down := foreground(settings.UpColor), foreground(settings.DownColor)
+
+	tickers := testTickers()
+	tickers[1].PriceChangePercentage = -1
+
+	if rendered := renderCells(tickerCells(settings, tickers[0])); !strings.Contains(rendered, up+"+5.05") {
+		t.Errorf("expected the change to be drawn in the up color, got %q", rendered)
+	}
+	if rendered := renderCells(tickerCells(settings, tickers[1])); !strings.Contains(rendered, down) {
+		t.Errorf("expected the change to be drawn in the down color, got %q", rendered)
+	}
+}
+
+func TestBanner(t *testing.T) {
+	announcement := &models.Announcement{Message: "Markets close early"}
+
+	got := banner(announcement, 25)
+	if !strings.Contains(got, "   Markets close early   ") {
+		t.Errorf("expected the message to be centered, got %q", got)
+	}
+
+	got = banner(announcement, 10)
+	if !strings.Contains(got, "Markets ..") {
+		t.Errorf("expected the message to be truncated, got %q", got)
+	}
+}
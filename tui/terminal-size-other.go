@@ -0,0 +1,14 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package tui
+
+import (
+	"errors"
+	"os"
+)
+
+// terminalSize isn't supported on this platform, the terminal is assumed to be the default size.
+func terminalSize(f *os.File) (columns, rows int, err error) {
+	return 0, 0, errors.New("terminal size is not supported on this platform")
+}
@@ -0,0 +1,200 @@
+// Package tui is a terminal client for the ticker wall. It joins a wall as an observer, so it isn't
+// part of the screen layout, and draws the tape as text so the wall can be watched over SSH.
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/polygon-io/go-app-ticker-wall/client"
+	"github.com/polygon-io/go-app-ticker-wall/layout"
+	"github.com/polygon-io/go-app-ticker-wall/models"
+)
+
+// ANSI escape codes used to control the terminal.
+const (
+	enterAltScreen = "\x1b[?1049h"
+	exitAltScreen  = "\x1b[?1049l"
+	hideCursor     = "\x1b[?25l"
+	showCursor     = "\x1b[?25h"
+	cursorHome     = "\x1b[H"
+	clearLine      = "\x1b[K"
+	clearBelow     = "\x1b[J"
+)
+
+// defaultColumns is the width of the terminal when it can't be measured.
+const defaultColumns = 80
+
+type TUI struct {
+	client client.Client
+	out    *os.File
+
+	// announcements which are showing, or waiting to be shown.
+	announcementsLock sync.Mutex
+	announcements     []*models.Announcement
+}
+
+func NewTUI(clientObj client.Client, out *os.File) *TUI {
+	return &TUI{
+		client: clientObj,
+		out:    out,
+	}
+}
+
+// Run draws frames to the terminal until the context is done.
+func (t *TUI) Run(ctx context.Context, fps int) error {
+	go t.listenForAnnouncements(ctx)
+
+	io.WriteString(t.out, enterAltScreen+hideCursor)
+	defer io.WriteString(t.out, styleReset+showCursor+exitAltScreen)
+
+	timer1 := time.NewTicker(time.Second / time.Duration(fps))
+	defer timer1.Stop()
+
+	for {
+		io.WriteString(t.out, t.renderFrame(t.columns()))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer1.C:
+		}
+	}
+}
+
+// listenForAnnouncements listens on the clients announcements channel, and queues them up to be shown.
+func (t *TUI) listenForAnnouncements(ctx context.Context) {
+	announcements := t.client.GetAnnouncements()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case announcement := <-announcements:
+			t.announcementsLock.Lock()
+			t.announcements = append(t.announcements, announcement)
+			t.announcementsLock.Unlock()
+		}
+	}
+}
+
+// columns gets the width of the terminal. Falls back to $COLUMNS, then the default width, when the
+// output isn't a terminal.
+func (t *TUI) columns() int {
+	if columns, _, err := terminalSize(t.out); err == nil && columns > 0 {
+		return columns
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return defaultColumns
+}
+
+// renderFrame draws the status line, the tape, and any announcement which is showing.
+func (t *TUI) renderFrame(columns int) string {
+	lines := []string{t.statusLine(columns)}
+
+	cluster := t.client.GetCluster()
+	if cluster == nil {
+		lines = append(lines, "", styleDim+"Waiting on the leader.."+styleReset)
+	} else {
+		settings := t.client.GetSettings()
+		tickers := t.client.GetTickers()
+		now := t.client.Now()
+
+		// The terminal follows the left edge of the first screen on the wall.
+		position := layout.TapeOffset(settings, len(tickers), now)
+
+		lines = append(lines, "", renderCells(newTape(settings, tickers).window(position, columns)), "")
+		if announcement := t.currentAnnouncement(now); announcement != nil {
+			lines = append(lines, banner(announcement, columns))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(cursorHome)
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteString(clearLine + "\r\n")
+	}
+	b.WriteString(clearBelow)
+	return b.String()
+}
+
+// statusLine shows which wall we are watching, and how the connection to the leader is.
+func (t *TUI) statusLine(columns int) string {
+	status := "Connected"
+	switch t.client.GetStatus().GRPCStatus {
+	case client.GRPCStatusReconnecting:
+		status = "Reconnecting to Leader.."
+	case client.GRPCStatusDisconnected:
+		status = "Disconnected from Leader.."
+	}
+
+	line := fmt.Sprintf("Polygon.io Ticker Wall  |  Wall: %s  |  Tickers: %d  |  %s",
+		t.client.GetScreen().Wall, len(t.client.GetTickers()), status)
+	return styleDim + truncate(line, columns) + styleReset
+}
+
+// currentAnnouncement gets the announcement which should be showing now, if any, and forgets about
+// announcements which have finished.
+func (t *TUI) currentAnnouncement(now time.Time) *models.Announcement {
+	t.announcementsLock.Lock()
+	defer t.announcementsLock.Unlock()
+
+	nowMS := now.UnixMilli()
+	var current *models.Announcement
+	valid := t.announcements[:0]
+	for _, announcement := range t.announcements {
+		if nowMS > announcement.ShowAtTimestampMS+announcement.LifespanMS {
+			continue
+		}
+		valid = append(valid, announcement)
+
+		// The newest announcement wins when more than one is showing.
+		if nowMS >= announcement.ShowAtTimestampMS {
+			current = announcement
+		}
+	}
+
+	// Prevent memory leak by erasing the finished announcements.
+	for i := len(valid); i < len(t.announcements); i++ {
+		t.announcements[i] = nil
+	}
+	t.announcements = valid
+
+	return current
+}
+
+// banner draws an announcement across the full width of the terminal, colored by it's type.
+func banner(announcement *models.Announcement, columns int) string {
+	background := "\x1b[48;2;122;122;255m"
+	if announcement.AnnouncementType == int32(models.AnnouncementTypeDanger) {
+		background = "\x1b[48;2;255;122;122m"
+	} else if announcement.AnnouncementType == int32(models.AnnouncementTypeSuccess) {
+		background = "\x1b[48;2;122;255;122m"
+	}
+
+	message := []rune(truncate(announcement.Message, columns))
+	left := (columns - len(message)) / 2
+	right := columns - len(message) - left
+
+	return background + styleBold + "\x1b[97m" + strings.Repeat(" ", left) + string(message) + strings.Repeat(" ", right) + styleReset
+}
+
+// truncate shortens the text to fit within the given number of columns.
+func truncate(text string, columns int) string {
+	runes := []rune(text)
+	if len(runes) <= columns {
+		return text
+	}
+	if columns < 3 {
+		return string(runes[:columns])
+	}
+	return string(runes[:columns-2]) + ".."
+}